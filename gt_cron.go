@@ -0,0 +1,479 @@
+package gt
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+/*
+Shortcut: parses successfully or panics. Should be used only in root scope. When
+error handling is relevant, use `.Parse`.
+*/
+func ParseCron(src string) (val Cron) {
+	try(val.Parse(src))
+	return
+}
+
+/*
+Validated cron expression. Supports the following formats:
+
+  - Standard 5 fields: "minute hour day-of-month month day-of-week".
+  - Extended 6 fields: "second minute hour day-of-month month day-of-week".
+  - Macros: "@yearly", "@annually", "@monthly", "@weekly", "@daily",
+    "@midnight", "@hourly".
+
+Each field is a comma-separated list of `*`, single values, ranges `a-b`, and
+steps `a-b/n` or `a/n`, where `a` may be `*`. Months and weekdays may be specified with
+three-letter English names such as "JAN" or "MON", case-insensitively. In the
+day-of-week field, both 0 and 7 mean Sunday. In day fields, `?` is a synonym for
+`*`. Like in Vixie cron, when both day-of-month and day-of-week are restricted
+(don't start with `*`), a day matches if it matches EITHER of them.
+
+Features:
+
+  - Reversible encoding/decoding in text.
+  - Reversible encoding/decoding in JSON.
+  - Reversible encoding/decoding in SQL.
+  - Text encoding preserves the source expression, with whitespace normalized.
+  - Decoding validates the expression.
+  - Computes adjacent occurrences via `.Next` and `.Prev`.
+
+The zero value of `gt.Cron` never matches and is encoded as an empty string,
+which can't be decoded back. For a nullable variant, see `gt.NullCron`.
+*/
+type Cron struct {
+	src    string
+	second uint64
+	minute uint64
+	hour   uint64
+	dom    uint64
+	month  uint64
+	dow    uint64
+	domAny bool
+	dowAny bool
+}
+
+var (
+	_ = Encodable(Cron{})
+	_ = Decodable((*Cron)(nil))
+)
+
+var cronMacros = map[string]string{
+	`@yearly`:   `0 0 1 1 *`,
+	`@annually`: `0 0 1 1 *`,
+	`@monthly`:  `0 0 1 * *`,
+	`@weekly`:   `0 0 * * 0`,
+	`@daily`:    `0 0 * * *`,
+	`@midnight`: `0 0 * * *`,
+	`@hourly`:   `0 * * * *`,
+}
+
+var (
+	cronMonthNames = []string{`JAN`, `FEB`, `MAR`, `APR`, `MAY`, `JUN`, `JUL`, `AUG`, `SEP`, `OCT`, `NOV`, `DEC`}
+	cronDayNames   = []string{`SUN`, `MON`, `TUE`, `WED`, `THU`, `FRI`, `SAT`}
+)
+
+/*
+Max distance in years for `gt.Cron.Next` and `gt.Cron.Prev`. Expressions such
+as "0 0 30 2 *" never match; this guarantees termination. 28 years is the
+period of the Gregorian calendar for weekdays of a given date, except around
+century years, hence some margin.
+*/
+const cronMaxYears = 32
+
+// Implement `gt.Zeroable`. Equivalent to `reflect.ValueOf(self).IsZero()`.
+func (self Cron) IsZero() bool { return self == Cron{} }
+
+// Implement `gt.Nullable`. Always `false`.
+func (self Cron) IsNull() bool { return false }
+
+// Implement `gt.Getter`, using `.String` to return a string representation.
+func (self Cron) Get() any { return self.String() }
+
+// Implement `gt.Setter`, using `.Scan`. Panics on error.
+func (self *Cron) Set(src any) { try(self.Scan(src)) }
+
+// Implement `gt.Zeroer`, zeroing the receiver.
+func (self *Cron) Zero() {
+	if self != nil {
+		*self = Cron{}
+	}
+}
+
+/*
+Implement `fmt.Stringer`, returning the source expression with whitespace
+normalized to single spaces.
+*/
+func (self Cron) String() string { return self.src }
+
+/*
+Implement `gt.Parser`, parsing and validating a cron expression. Surrounding
+whitespace is ignored. Empty input is rejected.
+*/
+func (self *Cron) Parse(src string) (err error) {
+	defer errParse(&err, src, `cron expression`)
+
+	fields := strings.Fields(src)
+	if len(fields) <= 0 {
+		return fmt.Errorf(`[gt] unexpected empty cron expression`)
+	}
+
+	var buf Cron
+	buf.src = strings.Join(fields, ` `)
+
+	if len(fields) == 1 && strings.HasPrefix(fields[0], `@`) {
+		exp, ok := cronMacros[strings.ToLower(fields[0])]
+		if !ok {
+			return fmt.Errorf(`[gt] unrecognized cron macro %q`, fields[0])
+		}
+		fields = strings.Fields(exp)
+	}
+
+	switch len(fields) {
+	case 5:
+		buf.second = 1
+	case 6:
+		buf.second, err = parseCronField(fields[0], 0, 59, nil)
+		if err != nil {
+			return err
+		}
+		fields = fields[1:]
+	default:
+		return fmt.Errorf(`[gt] expected 5 or 6 cron fields, got %v`, len(fields))
+	}
+
+	buf.minute, err = parseCronField(fields[0], 0, 59, nil)
+	if err != nil {
+		return err
+	}
+	buf.hour, err = parseCronField(fields[1], 0, 23, nil)
+	if err != nil {
+		return err
+	}
+	buf.dom, err = parseCronField(fields[2], 1, 31, nil)
+	if err != nil {
+		return err
+	}
+	buf.month, err = parseCronField(fields[3], 1, 12, cronMonthNames)
+	if err != nil {
+		return err
+	}
+	buf.dow, err = parseCronField(fields[4], 0, 7, cronDayNames)
+	if err != nil {
+		return err
+	}
+
+	// Sunday is both 0 and 7.
+	if buf.dow&(1<<7) != 0 {
+		buf.dow = (buf.dow | 1) &^ (1 << 7)
+	}
+
+	buf.domAny = isCronAny(fields[2])
+	buf.dowAny = isCronAny(fields[4])
+
+	*self = buf
+	return nil
+}
+
+// Implement `gt.AppenderTo`, using the same representation as `.String`.
+func (self Cron) AppendTo(buf []byte) []byte { return append(buf, self.src...) }
+
+// Implement `encoding.TextMarhaler`, using the same representation as `.String`.
+func (self Cron) MarshalText() ([]byte, error) {
+	return self.AppendTo(nil), nil
+}
+
+// Implement `encoding.TextUnmarshaler`, using the same algorithm as `.Parse`.
+func (self *Cron) UnmarshalText(src []byte) error {
+	return self.Parse(bytesString(src))
+}
+
+/*
+Implement `json.Marshaler`, returning bytes representing a JSON string with the
+same text as in `.String`.
+*/
+func (self Cron) MarshalJSON() ([]byte, error) {
+	buf := make([]byte, 0, len(self.src)+2)
+	buf = append(buf, '"')
+	buf = self.AppendTo(buf)
+	buf = append(buf, '"')
+	return buf, nil
+}
+
+// Implement `json.Unmarshaler`, using the same algorithm as `.Parse`.
+func (self *Cron) UnmarshalJSON(src []byte) error {
+	if isJsonStr(src) {
+		return self.UnmarshalText(cutJsonStr(src))
+	}
+	return errJsonString(src, self)
+}
+
+// Implement `driver.Valuer`, using `.Get`.
+func (self Cron) Value() (driver.Value, error) {
+	return self.Get(), nil
+}
+
+/*
+Implement `sql.Scanner`, converting an arbitrary input to `gt.Cron` and
+modifying the receiver. Acceptable inputs:
+
+  - `string`      -> use `.Parse`
+  - `[]byte`      -> use `.UnmarshalText`
+  - `gt.Cron`     -> assign
+  - `gt.NullCron` -> assign
+  - `gt.Getter`   -> scan underlying value
+*/
+func (self *Cron) Scan(src any) error {
+	switch src := src.(type) {
+	case string:
+		return self.Parse(src)
+
+	case []byte:
+		return self.UnmarshalText(src)
+
+	case Cron:
+		*self = src
+		return nil
+
+	case NullCron:
+		*self = Cron(src)
+		return nil
+
+	default:
+		val, ok := get(src)
+		if ok {
+			return self.Scan(val)
+		}
+		return errScanType(self, src)
+	}
+}
+
+/*
+True if the given time matches the expression, in the time's own location.
+Sub-second precision is ignored.
+*/
+func (self Cron) Match(val NullTime) bool {
+	inst := val.Time()
+	return !self.IsZero() &&
+		self.matchMonth(inst) &&
+		self.matchDay(inst) &&
+		hasBit(self.hour, inst.Hour()) &&
+		hasBit(self.minute, inst.Minute()) &&
+		hasBit(self.second, inst.Second())
+}
+
+/*
+Returns the earliest time strictly after the given time that matches the
+expression. Calculations are performed in wall-clock time of the input's
+location; use `gt.NullTime.In` to choose the location. Wall-clock times skipped
+by DST transitions don't occur, while repeated wall-clock times may occur
+twice. If the input is zero, or if there is no match within the next few
+decades, returns zero.
+*/
+func (self Cron) Next(val NullTime) NullTime {
+	if self.IsZero() || val.IsZero() {
+		return NullTime{}
+	}
+
+	inst := val.Time()
+	loc := inst.Location()
+	inst = cronTruncSecond(inst).Add(time.Second)
+	limit := inst.Year() + cronMaxYears
+
+	for inst.Year() <= limit {
+		year, month, day := inst.Date()
+
+		if !self.matchMonth(inst) {
+			inst = cronMidnight(year, month+1, 1, loc)
+			continue
+		}
+		if !self.matchDay(inst) {
+			inst = cronMidnight(year, month, day+1, loc)
+			continue
+		}
+		if !hasBit(self.hour, inst.Hour()) {
+			inst = cronTruncHour(inst).Add(time.Hour)
+			continue
+		}
+		if !hasBit(self.minute, inst.Minute()) {
+			inst = cronTruncMinute(inst).Add(time.Minute)
+			continue
+		}
+		if !hasBit(self.second, inst.Second()) {
+			inst = inst.Add(time.Second)
+			continue
+		}
+		return NullTime(inst)
+	}
+	return NullTime{}
+}
+
+/*
+Returns the latest time strictly before the given time that matches the
+expression. Inverse of `.Next`, with the same caveats.
+*/
+func (self Cron) Prev(val NullTime) NullTime {
+	if self.IsZero() || val.IsZero() {
+		return NullTime{}
+	}
+
+	inst := val.Time()
+	loc := inst.Location()
+	inst = cronTruncSecond(inst.Add(-1))
+	limit := inst.Year() - cronMaxYears
+
+	for inst.Year() >= limit {
+		year, month, day := inst.Date()
+
+		if !self.matchMonth(inst) {
+			inst = cronMidnight(year, month, 1, loc).Add(-time.Second)
+			continue
+		}
+		if !self.matchDay(inst) {
+			inst = cronMidnight(year, month, day, loc).Add(-time.Second)
+			continue
+		}
+		if !hasBit(self.hour, inst.Hour()) {
+			inst = cronTruncHour(inst).Add(-time.Second)
+			continue
+		}
+		if !hasBit(self.minute, inst.Minute()) {
+			inst = cronTruncMinute(inst).Add(-time.Second)
+			continue
+		}
+		if !hasBit(self.second, inst.Second()) {
+			inst = inst.Add(-time.Second)
+			continue
+		}
+		return NullTime(inst)
+	}
+	return NullTime{}
+}
+
+func (self Cron) matchMonth(val time.Time) bool {
+	return hasBit(self.month, int(val.Month()))
+}
+
+func (self Cron) matchDay(val time.Time) bool {
+	dom := hasBit(self.dom, val.Day())
+	dow := hasBit(self.dow, int(val.Weekday()))
+	if self.domAny || self.dowAny {
+		return dom && dow
+	}
+	return dom || dow
+}
+
+func parseCronField(src string, min, max int, names []string) (out uint64, err error) {
+	for len(src) > 0 {
+		var part string
+		part, src = cutRecurrencePart(src, ',')
+
+		val, err := parseCronPart(part, min, max, names)
+		if err != nil {
+			return 0, fmt.Errorf(`[gt] invalid cron field %q: %w`, part, err)
+		}
+		out |= val
+	}
+	if out == 0 {
+		return 0, fmt.Errorf(`[gt] unexpected empty cron field`)
+	}
+	return
+}
+
+func parseCronPart(src string, min, max int, names []string) (uint64, error) {
+	span, stepSrc, hasStep := strings.Cut(src, `/`)
+	step := 1
+
+	if hasStep {
+		val, err := strconv.Atoi(stepSrc)
+		if err != nil {
+			return 0, err
+		}
+		if val <= 0 {
+			return 0, fmt.Errorf(`step must be positive, got %v`, val)
+		}
+		step = val
+	}
+
+	var lo, hi int
+	if span == `*` || span == `?` {
+		lo, hi = min, max
+	} else {
+		loSrc, hiSrc, isRange := strings.Cut(span, `-`)
+
+		val, err := parseCronValue(loSrc, min, max, names)
+		if err != nil {
+			return 0, err
+		}
+		lo, hi = val, val
+
+		if isRange {
+			hi, err = parseCronValue(hiSrc, min, max, names)
+			if err != nil {
+				return 0, err
+			}
+			if hi < lo {
+				return 0, fmt.Errorf(`range start %v exceeds range end %v`, lo, hi)
+			}
+		} else if hasStep {
+			hi = max
+		}
+	}
+
+	var out uint64
+	for ind := lo; ind <= hi; ind += step {
+		out |= 1 << uint(ind)
+	}
+	return out, nil
+}
+
+func parseCronValue(src string, min, max int, names []string) (int, error) {
+	for ind, name := range names {
+		if strings.EqualFold(name, src) {
+			return ind + min, nil
+		}
+	}
+
+	val, err := strconv.Atoi(src)
+	if err != nil {
+		return 0, err
+	}
+	if val < min || val > max {
+		return 0, fmt.Errorf(`value %v out of range [%v,%v]`, val, min, max)
+	}
+	return val, nil
+}
+
+func isCronAny(src string) bool {
+	return len(src) > 0 && (src[0] == '*' || src[0] == '?')
+}
+
+func hasBit(set uint64, ind int) bool { return set&(1<<uint(ind)) != 0 }
+
+func cronTruncSecond(val time.Time) time.Time {
+	return val.Add(-time.Duration(val.Nanosecond()))
+}
+
+func cronTruncMinute(val time.Time) time.Time {
+	return cronTruncSecond(val).Add(-time.Duration(val.Second()) * time.Second)
+}
+
+func cronTruncHour(val time.Time) time.Time {
+	return cronTruncMinute(val).Add(-time.Duration(val.Minute()) * time.Minute)
+}
+
+/*
+Returns the start of the given day. When midnight is skipped by a DST
+transition, `time.Date` may normalize it to the previous day, which would make
+the caller loop forever, so we adjust it to the first instant of the day.
+*/
+func cronMidnight(year int, month time.Month, day int, loc *time.Location) time.Time {
+	val := time.Date(year, month, day, 0, 0, 0, 0, loc)
+	if hour := val.Hour(); hour > 12 {
+		val = val.Add(time.Duration(24-hour) * time.Hour)
+	}
+	return val
+}
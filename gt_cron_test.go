@@ -0,0 +1,98 @@
+package gt_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/mitranim/gt"
+)
+
+func TestNullCron_common(t *testing.T) {
+	var (
+		primZero    = any(nil)
+		primNonZero = `*/15 9-17 * * MON-FRI`
+		textZero    = ``
+		textNonZero = primNonZero
+		jsonZero    = bytesNull
+		jsonNonZero = jsonBytes(textNonZero)
+		zero        = gt.NullCron{}
+		nonZero     = gt.ParseNullCron(textNonZero)
+		dec         = new(gt.NullCron)
+	)
+
+	eq(true, zero.IsNull())
+	eq(false, nonZero.IsNull())
+
+	testAny(t, primZero, primNonZero, textZero, textNonZero, jsonZero, jsonNonZero, zero, nonZero, dec)
+}
+
+func TestCron_Parse(t *testing.T) {
+	eq(`0 0 * * *`, gt.ParseCron(" 0  0 * *\t* ").String())
+	eq(`@daily`, gt.ParseCron(`@daily`).String())
+	eq(gt.ParseCron(`0 0 * * 0`).Next(gt.NullTimeUTC(2024, 1, 1, 0, 0, 0, 0)), gt.ParseCron(`0 0 * * 7`).Next(gt.NullTimeUTC(2024, 1, 1, 0, 0, 0, 0)))
+
+	var val gt.Cron
+	fail(val.Parse(``))
+	fail(val.Parse(`* * * *`))
+	fail(val.Parse(`* * * * * * *`))
+	fail(val.Parse(`60 * * * *`))
+	fail(val.Parse(`* 24 * * *`))
+	fail(val.Parse(`* * 0 * *`))
+	fail(val.Parse(`* * * 13 *`))
+	fail(val.Parse(`* * * * 8`))
+	fail(val.Parse(`5-1 * * * *`))
+	fail(val.Parse(`*/0 * * * *`))
+	fail(val.Parse(`* * * FOO *`))
+	fail(val.Parse(`@reboot`))
+	eq(gt.Cron{}, val)
+}
+
+func TestCron_Next(t *testing.T) {
+	test := func(exp gt.NullTime, src string, val gt.NullTime) {
+		t.Helper()
+		eq(exp, gt.ParseCron(src).Next(val))
+	}
+
+	test(gt.NullTime{}, `* * * * *`, gt.NullTime{})
+	test(gt.NullTimeUTC(2024, 1, 1, 0, 1, 0, 0), `* * * * *`, gt.NullTimeUTC(2024, 1, 1, 0, 0, 0, 0))
+	test(gt.NullTimeUTC(2024, 1, 1, 0, 1, 0, 0), `* * * * *`, gt.NullTimeUTC(2024, 1, 1, 0, 0, 59, 999))
+	test(gt.NullTimeUTC(2024, 1, 1, 0, 0, 1, 0), `* * * * * *`, gt.NullTimeUTC(2024, 1, 1, 0, 0, 0, 0))
+	test(gt.NullTimeUTC(2024, 1, 2, 0, 0, 0, 0), `@daily`, gt.NullTimeUTC(2024, 1, 1, 0, 0, 0, 0))
+	test(gt.NullTimeUTC(2024, 1, 1, 9, 15, 0, 0), `*/15 9-17 * * MON-FRI`, gt.NullTimeUTC(2024, 1, 1, 9, 0, 0, 0))
+	test(gt.NullTimeUTC(2024, 1, 8, 9, 0, 0, 0), `*/15 9-17 * * MON-FRI`, gt.NullTimeUTC(2024, 1, 5, 17, 45, 0, 0))
+	test(gt.NullTimeUTC(2028, 2, 29, 0, 0, 0, 0), `0 0 29 2 *`, gt.NullTimeUTC(2024, 3, 1, 0, 0, 0, 0))
+	test(gt.NullTime{}, `0 0 30 2 *`, gt.NullTimeUTC(2024, 1, 1, 0, 0, 0, 0))
+
+	// Day-of-month OR day-of-week, like in Vixie cron.
+	test(gt.NullTimeUTC(2024, 1, 5, 0, 0, 0, 0), `0 0 13 * FRI`, gt.NullTimeUTC(2024, 1, 1, 0, 0, 0, 0))
+
+	t.Run(`location`, func(t *testing.T) {
+		loc, err := time.LoadLocation(`Europe/Berlin`)
+		try(err)
+
+		eq(
+			gt.NullTimeIn(2024, 3, 31, 9, 0, 0, 0, loc),
+			gt.ParseCron(`0 9 * * *`).Next(gt.NullTimeIn(2024, 3, 30, 9, 0, 0, 0, loc)),
+		)
+
+		// 02:30 doesn't exist on the day of the DST transition.
+		eq(
+			gt.NullTimeIn(2024, 4, 1, 2, 30, 0, 0, loc),
+			gt.ParseCron(`30 2 * * *`).Next(gt.NullTimeIn(2024, 3, 31, 0, 0, 0, 0, loc)),
+		)
+	})
+}
+
+func TestCron_Prev(t *testing.T) {
+	test := func(exp gt.NullTime, src string, val gt.NullTime) {
+		t.Helper()
+		eq(exp, gt.ParseCron(src).Prev(val))
+	}
+
+	test(gt.NullTime{}, `* * * * *`, gt.NullTime{})
+	test(gt.NullTimeUTC(2023, 12, 31, 23, 59, 0, 0), `* * * * *`, gt.NullTimeUTC(2024, 1, 1, 0, 0, 0, 0))
+	test(gt.NullTimeUTC(2024, 1, 1, 0, 0, 0, 0), `* * * * *`, gt.NullTimeUTC(2024, 1, 1, 0, 0, 0, 1))
+	test(gt.NullTimeUTC(2024, 1, 1, 0, 0, 0, 0), `@monthly`, gt.NullTimeUTC(2024, 1, 31, 0, 0, 0, 0))
+	test(gt.NullTimeUTC(2024, 1, 5, 17, 45, 0, 0), `*/15 9-17 * * MON-FRI`, gt.NullTimeUTC(2024, 1, 8, 9, 0, 0, 0))
+	test(gt.NullTimeUTC(2024, 2, 29, 0, 0, 0, 0), `0 0 29 2 *`, gt.NullTimeUTC(2028, 2, 29, 0, 0, 0, 0))
+}
@@ -0,0 +1,471 @@
+package gt
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	r "reflect"
+	"strconv"
+	"strings"
+	"unsafe"
+)
+
+/*
+Constraint for enum types used with `gt.Enum` and `gt.NullEnum`. The type must
+be backed by a string or an integer, and must list its allowed values via
+`.EnumValues`, which is called on the zero value. Example:
+
+	type Role string
+
+	const (
+		RoleAdmin  Role = `admin`
+		RoleMember Role = `member`
+	)
+
+	func (Role) EnumValues() []Role { return roles }
+
+	var roles = []Role{RoleAdmin, RoleMember}
+
+	type User struct {
+		Role gt.Enum[Role]     `json:"role"`
+		Prev gt.NullEnum[Role] `json:"prev"`
+	}
+
+`.EnumValues` is called on every decode. Returning a package-level slice, as
+above, avoids allocations.
+*/
+type Enumer[T any] interface {
+	~string |
+		~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64
+
+	EnumValues() []T
+}
+
+/*
+Implemented by `gt.Enum` and `gt.NullEnum`. Lists allowed values without
+knowledge of the type parameter, for example for generating OpenAPI schemas
+via reflection. Values are `string`, `int64`, or `uint64`, as in `.Get`.
+*/
+type EnumLister interface{ EnumList() []any }
+
+/*
+Shortcut: converts the value to `gt.Enum`, panicking if the value is not listed
+in `T.EnumValues`. Should be used only in root scope. When error handling is
+relevant, use `gt.Enum.Scan`.
+*/
+func EnumOf[T Enumer[T]](val T) (out Enum[T]) {
+	try(out.Scan(val))
+	return
+}
+
+/*
+Shortcut: parses successfully or panics. Should be used only in root scope. When
+error handling is relevant, use `.Parse`.
+*/
+func ParseEnum[T Enumer[T]](src string) (val Enum[T]) {
+	try(val.Parse(src))
+	return
+}
+
+/*
+Non-nullable enum: a value of `T` which is guaranteed to be one of
+`T.EnumValues` when decoded. Decoding unknown values via `.Parse`, `.Scan`,
+`.UnmarshalText` and `.UnmarshalJSON` fails with a descriptive error, which
+prevents typos from reaching the database.
+
+String-backed enums are encoded as strings in text, JSON and SQL.
+Integer-backed enums are encoded as decimal numbers in text, JSON numbers in
+JSON, and integers in SQL.
+
+The zero value contains the zero value of `T`, which may or may not be listed
+in `T.EnumValues`. For a nullable variant, see `gt.NullEnum`.
+*/
+type Enum[T Enumer[T]] struct{ val T }
+
+var (
+	_ = Encodable(Enum[enumExample]{})
+	_ = Decodable((*Enum[enumExample])(nil))
+	_ = EnumLister(Enum[enumExample]{})
+)
+
+// Implement `gt.Zeroable`. True if the underlying value is the zero value of `T`.
+func (self Enum[T]) IsZero() bool {
+	var zero T
+	return self.val == zero
+}
+
+// Implement `gt.Nullable`. Always `false`.
+func (self Enum[T]) IsNull() bool { return false }
+
+/*
+Implement `gt.Getter`. For string-backed enums, returns `string`. For
+integer-backed enums, returns `int64` or `uint64`.
+*/
+func (self Enum[T]) Get() any { return enumGet(self.val) }
+
+// Implement `gt.Setter`, using `.Scan`. Panics on error.
+func (self *Enum[T]) Set(src any) { try(self.Scan(src)) }
+
+// Implement `gt.Zeroer`, zeroing the receiver.
+func (self *Enum[T]) Zero() {
+	if self != nil {
+		*self = Enum[T]{}
+	}
+}
+
+/*
+Implement `fmt.Stringer`. For string-backed enums, returns the string as-is.
+For integer-backed enums, returns the decimal representation.
+*/
+func (self Enum[T]) String() string { return enumString(self.val) }
+
+/*
+Implement `gt.Parser`. Expects the input to match the text representation of one
+of `T.EnumValues`, otherwise returns an error listing the allowed values. The
+empty string is accepted only if listed.
+*/
+func (self *Enum[T]) Parse(src string) error {
+	val, err := enumParse[T](src)
+	if err != nil {
+		return err
+	}
+	self.val = val
+	return nil
+}
+
+// Implement `gt.AppenderTo`, using the same representation as `.String`.
+func (self Enum[T]) AppendTo(buf []byte) []byte {
+	return enumAppend(buf, self.val)
+}
+
+// Implement `encoding.TextMarhaler`, using the same representation as `.String`.
+func (self Enum[T]) MarshalText() ([]byte, error) {
+	return self.AppendTo(nil), nil
+}
+
+// Implement `encoding.TextUnmarshaler`, using the same algorithm as `.Parse`.
+func (self *Enum[T]) UnmarshalText(src []byte) error {
+	return self.Parse(bytesString(src))
+}
+
+/*
+Implement `json.Marshaler`. For string-backed enums, returns a JSON string. For
+integer-backed enums, returns a JSON number.
+*/
+func (self Enum[T]) MarshalJSON() ([]byte, error) {
+	return enumAppendJson(nil, self.val), nil
+}
+
+/*
+Implement `json.Unmarshaler`. For string-backed enums, expects a JSON string.
+For integer-backed enums, expects a JSON number. The value must be listed in
+`T.EnumValues`.
+*/
+func (self *Enum[T]) UnmarshalJSON(src []byte) error {
+	val, err := enumUnmarshalJson[T](src)
+	if err != nil {
+		return err
+	}
+	self.val = val
+	return nil
+}
+
+// Implement `driver.Valuer`, using `.Get`.
+func (self Enum[T]) Value() (driver.Value, error) {
+	return self.Get(), nil
+}
+
+/*
+Implement `sql.Scanner`, converting an arbitrary input to `gt.Enum` and
+modifying the receiver. Acceptable inputs:
+
+  - `string`        -> use `.Parse`
+  - `[]byte`        -> use `.UnmarshalText`
+  - `intN`, `uintN` -> validate and convert, only for integer-backed enums
+  - `T`             -> validate and assign
+  - `gt.Enum[T]`    -> assign
+  - `gt.Getter`     -> scan underlying value
+
+Unlike nullable types, doesn't accept `nil`.
+*/
+func (self *Enum[T]) Scan(src any) error {
+	switch src := src.(type) {
+	case string:
+		return self.Parse(src)
+
+	case []byte:
+		return self.UnmarshalText(src)
+
+	case T:
+		if !enumValid(src) {
+			return errEnumValue(enumString(src), src, enumAllowed[T]())
+		}
+		self.val = src
+		return nil
+
+	case Enum[T]:
+		*self = src
+		return nil
+
+	default:
+		val, ok, err := enumScanInt[T](src)
+		if ok {
+			if err == nil {
+				self.val = val
+			}
+			return err
+		}
+
+		inner, ok := get(src)
+		if ok && inner != nil {
+			return self.Scan(inner)
+		}
+		return errScanType(self, src)
+	}
+}
+
+// Returns the underlying value.
+func (self Enum[T]) Val() T { return self.val }
+
+// True if the underlying value is listed in `T.EnumValues`.
+func (self Enum[T]) IsValid() bool { return enumValid(self.val) }
+
+// Returns the allowed values, as listed by `T.EnumValues`.
+func (self Enum[T]) Values() []T { return enumValues[T]() }
+
+// Implement `gt.EnumLister`.
+func (self Enum[T]) EnumList() []any { return enumList[T]() }
+
+// Used only for compile-time checks.
+type enumExample string
+
+func (enumExample) EnumValues() []enumExample { return nil }
+
+func enumValues[T Enumer[T]]() []T {
+	var zero T
+	return zero.EnumValues()
+}
+
+func enumValid[T Enumer[T]](val T) bool {
+	for _, allowed := range enumValues[T]() {
+		if val == allowed {
+			return true
+		}
+	}
+	return false
+}
+
+func enumParse[T Enumer[T]](src string) (T, error) {
+	for _, val := range enumValues[T]() {
+		if enumString(val) == src {
+			return val, nil
+		}
+	}
+	var zero T
+	return zero, errEnumValue(src, zero, enumAllowed[T]())
+}
+
+func enumUnmarshalJson[T Enumer[T]](src []byte) (T, error) {
+	var zero T
+
+	if enumIsString[T]() {
+		if !isJsonStr(src) {
+			return zero, errJsonString(src, zero)
+		}
+
+		// Enum values rarely contain escapes. When there are none, the content
+		// between the quotes is the string itself.
+		if bytes.IndexByte(src, '\\') < 0 {
+			return enumParse[T](bytesString(cutJsonStr(src)))
+		}
+
+		var str string
+		err := json.Unmarshal(src, &str)
+		if err != nil {
+			return zero, err
+		}
+		return enumParse[T](str)
+	}
+
+	if !isIntString(bytesString(src)) {
+		return zero, errJsonNumber(src, zero)
+	}
+	return enumParse[T](bytesString(src))
+}
+
+// Scans integers into integer-backed enums. Returns false for other inputs.
+func enumScanInt[T Enumer[T]](src any) (T, bool, error) {
+	var zero T
+	if enumIsString[T]() {
+		return zero, false, nil
+	}
+
+	var buf [24]byte
+	var str []byte
+
+	switch src := src.(type) {
+	case int:
+		str = strconv.AppendInt(buf[:0], int64(src), 10)
+	case int8:
+		str = strconv.AppendInt(buf[:0], int64(src), 10)
+	case int16:
+		str = strconv.AppendInt(buf[:0], int64(src), 10)
+	case int32:
+		str = strconv.AppendInt(buf[:0], int64(src), 10)
+	case int64:
+		str = strconv.AppendInt(buf[:0], src, 10)
+	case uint:
+		str = strconv.AppendUint(buf[:0], uint64(src), 10)
+	case uint8:
+		str = strconv.AppendUint(buf[:0], uint64(src), 10)
+	case uint16:
+		str = strconv.AppendUint(buf[:0], uint64(src), 10)
+	case uint32:
+		str = strconv.AppendUint(buf[:0], uint64(src), 10)
+	case uint64:
+		str = strconv.AppendUint(buf[:0], src, 10)
+	default:
+		// Named integer types other than `T`.
+		rval := r.ValueOf(src)
+		switch rval.Kind() {
+		case r.Int, r.Int8, r.Int16, r.Int32, r.Int64:
+			str = strconv.AppendInt(buf[:0], rval.Int(), 10)
+		case r.Uint, r.Uint8, r.Uint16, r.Uint32, r.Uint64:
+			str = strconv.AppendUint(buf[:0], rval.Uint(), 10)
+		default:
+			return zero, false, nil
+		}
+	}
+
+	val, err := enumParse[T](string(str))
+	return val, true, err
+}
+
+const (
+	enumKindString = iota
+	enumKindInt
+	enumKindUint
+)
+
+/*
+Underlying kind of `T`. Types which are not named are handled by a type switch.
+For named types, falls back on `reflect.TypeOf` of the zero value, which only
+reads the type from an interface without allocating, unlike `reflect.ValueOf`.
+*/
+func enumKind[T Enumer[T]]() int {
+	var zero T
+
+	switch any(zero).(type) {
+	case string:
+		return enumKindString
+	case int, int8, int16, int32, int64:
+		return enumKindInt
+	case uint, uint8, uint16, uint32, uint64:
+		return enumKindUint
+	}
+
+	switch r.TypeOf(zero).Kind() {
+	case r.String:
+		return enumKindString
+	case r.Int, r.Int8, r.Int16, r.Int32, r.Int64:
+		return enumKindInt
+	default:
+		return enumKindUint
+	}
+}
+
+func enumIsString[T Enumer[T]]() bool { return enumKind[T]() == enumKindString }
+
+/*
+The following functions read the underlying value according to `enumKind`,
+reinterpreting the memory of `T` as the corresponding builtin type. Must be
+called only for the matching kind.
+*/
+
+func enumStr[T Enumer[T]](val T) string {
+	return *(*string)(unsafe.Pointer(&val))
+}
+
+func enumInt[T Enumer[T]](val T) int64 {
+	ptr := unsafe.Pointer(&val)
+	switch unsafe.Sizeof(val) {
+	case 1:
+		return int64(*(*int8)(ptr))
+	case 2:
+		return int64(*(*int16)(ptr))
+	case 4:
+		return int64(*(*int32)(ptr))
+	default:
+		return *(*int64)(ptr)
+	}
+}
+
+func enumUint[T Enumer[T]](val T) uint64 {
+	ptr := unsafe.Pointer(&val)
+	switch unsafe.Sizeof(val) {
+	case 1:
+		return uint64(*(*uint8)(ptr))
+	case 2:
+		return uint64(*(*uint16)(ptr))
+	case 4:
+		return uint64(*(*uint32)(ptr))
+	default:
+		return *(*uint64)(ptr)
+	}
+}
+
+func enumGet[T Enumer[T]](val T) any {
+	switch enumKind[T]() {
+	case enumKindString:
+		return enumStr(val)
+	case enumKindInt:
+		return enumInt(val)
+	default:
+		return enumUint(val)
+	}
+}
+
+func enumAppend[T Enumer[T]](buf []byte, val T) []byte {
+	switch enumKind[T]() {
+	case enumKindString:
+		return append(buf, enumStr(val)...)
+	case enumKindInt:
+		return strconv.AppendInt(buf, enumInt(val), 10)
+	default:
+		return strconv.AppendUint(buf, enumUint(val), 10)
+	}
+}
+
+func enumString[T Enumer[T]](val T) string {
+	if enumIsString[T]() {
+		return enumStr(val)
+	}
+	return bytesString(enumAppend(nil, val))
+}
+
+// String-backed enums are encoded as JSON strings, others as JSON numbers.
+func enumAppendJson[T Enumer[T]](buf []byte, val T) []byte {
+	if enumIsString[T]() {
+		return jsonAppendString(buf, enumStr(val))
+	}
+	return enumAppend(buf, val)
+}
+
+func enumList[T Enumer[T]]() []any {
+	vals := enumValues[T]()
+	out := make([]any, len(vals))
+	for ind, val := range vals {
+		out[ind] = enumGet(val)
+	}
+	return out
+}
+
+func enumAllowed[T Enumer[T]]() string {
+	vals := enumValues[T]()
+	out := make([]string, len(vals))
+	for ind, val := range vals {
+		out[ind] = strconv.Quote(enumString(val))
+	}
+	return strings.Join(out, `, `)
+}
@@ -0,0 +1,189 @@
+package gt_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/mitranim/gt"
+)
+
+type Role string
+
+const (
+	RoleAdmin  Role = `admin`
+	RoleMember Role = `member`
+)
+
+var roles = []Role{RoleAdmin, RoleMember}
+
+func (Role) EnumValues() []Role { return roles }
+
+type Level int16
+
+const (
+	LevelLow  Level = 1
+	LevelHigh Level = 3
+)
+
+func (Level) EnumValues() []Level { return []Level{LevelLow, LevelHigh} }
+
+type Sign int8
+
+func (Sign) EnumValues() []Sign { return []Sign{-1, 0, 1} }
+
+type Port uint16
+
+func (Port) EnumValues() []Port { return []Port{80, 65535} }
+
+type Code uint64
+
+func (Code) EnumValues() []Code { return []Code{1<<64 - 1} }
+
+type Quote string
+
+func (Quote) EnumValues() []Quote { return []Quote{`say "hi"`, `a\b`} }
+
+func TestNullEnum_common(t *testing.T) {
+	var (
+		primZero    = any(nil)
+		primNonZero = `admin`
+		textZero    = ``
+		textNonZero = `admin`
+		jsonZero    = bytesNull
+		jsonNonZero = []byte(`"admin"`)
+		zero        = gt.NullEnum[Role]{}
+		nonZero     = gt.NullEnumOf(RoleAdmin)
+		dec         = new(gt.NullEnum[Role])
+	)
+
+	eq(true, zero.IsNull())
+	eq(false, nonZero.IsNull())
+
+	testAny(t, primZero, primNonZero, textZero, textNonZero, jsonZero, jsonNonZero, zero, nonZero, dec)
+}
+
+func TestNullEnum_common_int(t *testing.T) {
+	var (
+		primZero    = any(nil)
+		primNonZero = int64(3)
+		textZero    = ``
+		textNonZero = `3`
+		jsonZero    = bytesNull
+		jsonNonZero = []byte(`3`)
+		zero        = gt.NullEnum[Level]{}
+		nonZero     = gt.NullEnumOf(LevelHigh)
+		dec         = new(gt.NullEnum[Level])
+	)
+
+	testAny(t, primZero, primNonZero, textZero, textNonZero, jsonZero, jsonNonZero, zero, nonZero, dec)
+}
+
+func TestEnum(t *testing.T) {
+	t.Run(`Parse`, func(t *testing.T) {
+		eq(RoleAdmin, gt.ParseEnum[Role](`admin`).Val())
+		eq(RoleMember, gt.ParseEnum[Role](`member`).Val())
+		eq(LevelHigh, gt.ParseEnum[Level](`3`).Val())
+
+		var val gt.Enum[Role]
+		fail(val.Parse(``))
+		fail(val.Parse(`Admin`))
+		fail(val.Parse(`admin `))
+		eq(gt.Enum[Role]{}, val)
+
+		panics(t, `unrecognized value "guest" for gt_test.Role, expected one of: "admin", "member"`, func() {
+			gt.ParseEnum[Role](`guest`)
+		})
+		panics(t, `expected one of: "1", "3"`, func() {
+			gt.ParseEnum[Level](`2`)
+		})
+	})
+
+	t.Run(`json`, func(t *testing.T) {
+		type Model struct {
+			Role  gt.Enum[Role]      `json:"role"`
+			Prev  gt.NullEnum[Role]  `json:"prev"`
+			Level gt.NullEnum[Level] `json:"level"`
+		}
+
+		eq(
+			`{"role":"member","prev":null,"level":1}`,
+			string(jsonBytes(Model{Role: gt.EnumOf(RoleMember), Level: gt.NullEnumOf(LevelLow)})),
+		)
+
+		var val Model
+		try(json.Unmarshal([]byte(`{"role":"admin","prev":"member","level":3}`), &val))
+		eq(Model{gt.EnumOf(RoleAdmin), gt.NullEnumOf(RoleMember), gt.NullEnumOf(LevelHigh)}, val)
+
+		fail(json.Unmarshal([]byte(`{"role":"guest"}`), &val))
+		fail(json.Unmarshal([]byte(`{"role":1}`), &val))
+		fail(json.Unmarshal([]byte(`{"level":2}`), &val))
+		fail(json.Unmarshal([]byte(`{"level":"3"}`), &val))
+		fail(json.Unmarshal([]byte(`{"level":1.5}`), &val))
+	})
+
+	t.Run(`Scan`, func(t *testing.T) {
+		var role gt.Enum[Role]
+		try(role.Scan([]byte(`member`)))
+		eq(RoleMember, role.Val())
+		try(role.Scan(RoleAdmin))
+		eq(RoleAdmin, role.Val())
+		try(role.Scan(gt.NullString(`member`)))
+		eq(RoleMember, role.Val())
+		fail(role.Scan(Role(`guest`)))
+		fail(role.Scan(nil))
+		fail(role.Scan(int64(1)))
+
+		var level gt.NullEnum[Level]
+		try(level.Scan(int64(3)))
+		eq(LevelHigh, level.Val())
+		try(level.Scan(nil))
+		eq(true, level.IsNull())
+		try(level.Scan(uint8(1)))
+		eq(LevelLow, level.Val())
+		try(level.Scan(gt.NullInt(0)))
+		eq(true, level.IsNull())
+		fail(level.Scan(int64(2)))
+		fail(level.Scan(Level(2)))
+	})
+
+	t.Run(`validity`, func(t *testing.T) {
+		eq(false, gt.Enum[Role]{}.IsValid())
+		eq(true, gt.EnumOf(RoleAdmin).IsValid())
+		eq(true, gt.NullEnum[Role]{}.IsValid())
+		eq(true, gt.NullEnumOf(Role(``)).IsNull())
+		panics(t, `unrecognized value`, func() { gt.EnumOf(Role(`guest`)) })
+	})
+
+	t.Run(`EnumList`, func(t *testing.T) {
+		eq(roles, gt.Enum[Role]{}.Values())
+		eq([]any{`admin`, `member`}, gt.EnumLister(gt.Enum[Role]{}).EnumList())
+		eq([]any{int64(1), int64(3)}, gt.EnumLister(gt.NullEnum[Level]{}).EnumList())
+	})
+	t.Run(`kinds`, func(t *testing.T) {
+		eq(`-1`, gt.EnumOf(Sign(-1)).String())
+		eq(`-1`, string(jsonBytes(gt.EnumOf(Sign(-1)))))
+		eq(any(int64(-1)), gt.EnumOf(Sign(-1)).Get())
+		eq(Sign(-1), gt.ParseEnum[Sign](`-1`).Val())
+
+		eq(`65535`, gt.EnumOf(Port(65535)).String())
+		eq(`65535`, string(gt.EnumOf(Port(65535)).AppendTo(nil)))
+		eq(any(uint64(65535)), gt.EnumOf(Port(65535)).Get())
+
+		eq(`18446744073709551615`, string(jsonBytes(gt.NullEnumOf(Code(1<<64-1)))))
+		eq(any(uint64(1<<64-1)), gt.EnumOf(Code(1<<64-1)).Get())
+
+		eq(`""`, string(jsonBytes(gt.Enum[Role]{})))
+		eq(`null`, string(jsonBytes(gt.NullEnum[Role]{})))
+		eq(``, string(gt.NullEnum[Port]{}.AppendTo(nil)))
+
+		eq(`"say \"hi\""`, string(jsonBytes(gt.EnumOf(Quote(`say "hi"`)))))
+		eq(`"a\\b"`, string(jsonBytes(gt.EnumOf(Quote(`a\b`)))))
+
+		var val gt.Enum[Quote]
+		try(val.UnmarshalJSON([]byte(`"say \"hi\""`)))
+		eq(Quote(`say "hi"`), val.Val())
+		try(val.UnmarshalJSON([]byte(`"a\\b"`)))
+		eq(Quote(`a\b`), val.Val())
+		fail(val.UnmarshalJSON([]byte(`"a\b"`)))
+	})
+}
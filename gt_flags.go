@@ -0,0 +1,428 @@
+package gt
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"math/bits"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+/*
+Constraint for bit-flag types used with `gt.Flags` and `gt.NullFlags`. The type
+must be backed by an unsigned integer, and must name its bits via `.FlagNames`,
+which is called on the zero value. The name at index N belongs to the bit
+`1 << N`. Empty names mark unused bits. Names at indexes which exceed the bit
+width of the type have no bit, and are rejected when decoding. Example:
+
+	type Perm uint64
+
+	const (
+		PermRead  Perm = 1 << iota
+		PermWrite
+		PermAdmin
+	)
+
+	func (Perm) FlagNames() []string { return permNames }
+
+	var permNames = []string{`read`, `write`, `admin`}
+
+	type User struct {
+		Perms gt.Flags[Perm] `json:"perms"`
+	}
+
+`.FlagNames` is called on every encode and decode. Returning a package-level
+slice, as above, avoids allocations.
+*/
+type Flagger interface {
+	~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64
+
+	FlagNames() []string
+}
+
+/*
+Shortcut: combines the given flags into `gt.Flags`, panicking if any of them
+has unnamed bits. Should be used only in root scope. When error handling is
+relevant, use `gt.Flags.Scan`.
+*/
+func FlagsOf[T Flagger](vals ...T) (out Flags[T]) {
+	var val T
+	for _, flag := range vals {
+		val |= flag
+	}
+	try(out.Scan(val))
+	return
+}
+
+/*
+Shortcut: parses successfully or panics. Should be used only in root scope. When
+error handling is relevant, use `.Parse`.
+*/
+func ParseFlags[T Flagger](src string) (val Flags[T]) {
+	try(val.Parse(src))
+	return
+}
+
+/*
+Set of bit flags stored as an unsigned integer, where each bit has a name
+listed by `T.FlagNames`. Has the following representations:
+
+	text | "admin,read"         | names of set bits, sorted, comma-separated
+	JSON | ["admin","read"]     | names of set bits, sorted
+	SQL  | 5                    | integer, like `gt.NullUint`
+
+If the value has bits without names, which can only happen when constructed in
+Go code, they're encoded as a single trailing number, such as "read,8" or
+["read",8]. Decoding accepts names and numbers, in any order, as well as a
+plain integer such as "5" or 5, and rejects names or bits not listed in
+`T.FlagNames`.
+
+The zero value is the empty set, which is "" in text, [] in JSON and 0 in SQL.
+For a nullable variant, see `gt.NullFlags`.
+
+Because `.Set` implements `gt.Setter`, the operation of setting a flag is named
+`.Add`.
+*/
+type Flags[T Flagger] struct{ val T }
+
+var (
+	_ = Encodable(Flags[flagsExample]{})
+	_ = Decodable((*Flags[flagsExample])(nil))
+)
+
+// Implement `gt.Zeroable`. True if no bits are set.
+func (self Flags[T]) IsZero() bool { return self.val == 0 }
+
+// Implement `gt.Nullable`. Always `false`.
+func (self Flags[T]) IsNull() bool { return false }
+
+// Implement `gt.Getter`, returning `uint64`.
+func (self Flags[T]) Get() any { return uint64(self.val) }
+
+// Implement `gt.Setter`, using `.Scan`. Panics on error.
+func (self *Flags[T]) Set(src any) { try(self.Scan(src)) }
+
+// Implement `gt.Zeroer`, zeroing the receiver.
+func (self *Flags[T]) Zero() {
+	if self != nil {
+		*self = Flags[T]{}
+	}
+}
+
+/*
+Implement `fmt.Stringer`, returning the sorted names of set bits, separated with
+commas, such as "admin,read".
+*/
+func (self Flags[T]) String() string { return bytesString(self.AppendTo(nil)) }
+
+/*
+Implement `gt.Parser`. If the input is empty, zeroes the receiver. Otherwise
+expects an integer, or a comma-separated list of flag names and integers.
+Whitespace around list elements is ignored. Names and bits not listed in
+`T.FlagNames` are rejected.
+*/
+func (self *Flags[T]) Parse(src string) error {
+	if len(src) <= 0 {
+		self.Zero()
+		return nil
+	}
+
+	var val T
+	for _, elem := range strings.Split(src, `,`) {
+		flag, err := flagsParseElem[T](strings.TrimSpace(elem))
+		if err != nil {
+			return err
+		}
+		val |= flag
+	}
+	self.val = val
+	return nil
+}
+
+// Implement `gt.AppenderTo`, using the same representation as `.String`.
+func (self Flags[T]) AppendTo(buf []byte) []byte {
+	names, rest := flagsNames(self.val)
+	for ind, name := range names {
+		if ind > 0 {
+			buf = append(buf, ',')
+		}
+		buf = append(buf, name...)
+	}
+	if rest != 0 {
+		if len(names) > 0 {
+			buf = append(buf, ',')
+		}
+		buf = strconv.AppendUint(buf, uint64(rest), 10)
+	}
+	return buf
+}
+
+/*
+Implement `encoding.TextMarhaler`, using the same representation as `.String`.
+The empty set is a non-nil empty slice, since this type is not nullable.
+*/
+func (self Flags[T]) MarshalText() ([]byte, error) {
+	return self.AppendTo([]byte{}), nil
+}
+
+// Implement `encoding.TextUnmarshaler`, using the same algorithm as `.Parse`.
+func (self *Flags[T]) UnmarshalText(src []byte) error {
+	return self.Parse(bytesString(src))
+}
+
+/*
+Implement `json.Marshaler`, returning a JSON array of sorted names of set bits,
+followed by a number representing unnamed bits, if any.
+*/
+func (self Flags[T]) MarshalJSON() ([]byte, error) {
+	names, rest := flagsNames(self.val)
+
+	buf := make([]byte, 0, 64)
+	buf = append(buf, '[')
+	for ind, name := range names {
+		if ind > 0 {
+			buf = append(buf, ',')
+		}
+		buf = jsonAppendString(buf, name)
+	}
+	if rest != 0 {
+		if len(names) > 0 {
+			buf = append(buf, ',')
+		}
+		buf = strconv.AppendUint(buf, uint64(rest), 10)
+	}
+	buf = append(buf, ']')
+	return buf, nil
+}
+
+/*
+Implement `json.Unmarshaler`. If the input is empty or represents JSON `null`,
+zeroes the receiver. Otherwise accepts a JSON array of flag names and numbers,
+or a single number. Names and bits not listed in `T.FlagNames` are rejected.
+*/
+func (self *Flags[T]) UnmarshalJSON(src []byte) error {
+	if isJsonEmpty(src) {
+		self.Zero()
+		return nil
+	}
+
+	if isIntString(bytesString(src)) {
+		val, err := flagsParseElem[T](bytesString(src))
+		if err != nil {
+			return err
+		}
+		self.val = val
+		return nil
+	}
+
+	var elems []json.RawMessage
+	err := json.Unmarshal(src, &elems)
+	if err != nil {
+		return err
+	}
+
+	var val T
+	for _, elem := range elems {
+		var str string
+		if isJsonStr(elem) {
+			err := json.Unmarshal(elem, &str)
+			if err != nil {
+				return err
+			}
+			if len(str) <= 0 || isIntString(str) {
+				return errFlagName(str, val, flagsAllowed[T]())
+			}
+		} else {
+			str = bytesString(elem)
+		}
+
+		flag, err := flagsParseElem[T](str)
+		if err != nil {
+			return err
+		}
+		val |= flag
+	}
+	self.val = val
+	return nil
+}
+
+// Implement `driver.Valuer`, using `.Get`.
+func (self Flags[T]) Value() (driver.Value, error) {
+	return self.Get(), nil
+}
+
+/*
+Implement `sql.Scanner`, converting an arbitrary input to `gt.Flags` and
+modifying the receiver. Acceptable inputs:
+
+  - `string`      -> use `.Parse`
+  - `[]byte`      -> use `.UnmarshalText`
+  - `int64`       -> validate and convert
+  - `uint64`      -> validate and convert
+  - `T`           -> validate and assign
+  - `gt.Flags[T]` -> assign
+  - `gt.Getter`   -> scan underlying value
+
+Unlike nullable types, doesn't accept `nil`.
+*/
+func (self *Flags[T]) Scan(src any) error {
+	switch src := src.(type) {
+	case string:
+		return self.Parse(src)
+
+	case []byte:
+		return self.UnmarshalText(src)
+
+	case int64:
+		if src < 0 {
+			return errFlagBits(strconv.FormatInt(src, 10), self.val)
+		}
+		return self.Scan(uint64(src))
+
+	case uint64:
+		val := T(src)
+		if uint64(val) != src {
+			return errFlagBits(strconv.FormatUint(src, 10), self.val)
+		}
+		return self.Scan(val)
+
+	case T:
+		err := flagsValid(src)
+		if err != nil {
+			return err
+		}
+		self.val = src
+		return nil
+
+	case Flags[T]:
+		*self = src
+		return nil
+
+	default:
+		val, ok := get(src)
+		if ok && val != nil {
+			return self.Scan(val)
+		}
+		return errScanType(self, src)
+	}
+}
+
+// Returns the underlying value.
+func (self Flags[T]) Val() T { return self.val }
+
+// True if all bits of the given flag are set. False if the flag is zero.
+func (self Flags[T]) Has(flag T) bool { return flag != 0 && self.val&flag == flag }
+
+// True if any bit of the given flag is set.
+func (self Flags[T]) HasAny(flag T) bool { return self.val&flag != 0 }
+
+// Returns a copy with the bits of the given flag set.
+func (self Flags[T]) Add(flag T) Flags[T] { return Flags[T]{self.val | flag} }
+
+// Returns a copy with the bits of the given flag cleared.
+func (self Flags[T]) Clear(flag T) Flags[T] { return Flags[T]{self.val &^ flag} }
+
+// Returns a copy with the bits of the given flag flipped.
+func (self Flags[T]) Toggle(flag T) Flags[T] { return Flags[T]{self.val ^ flag} }
+
+// Returns the set of bits present in either the receiver or the input.
+func (self Flags[T]) Union(val Flags[T]) Flags[T] { return Flags[T]{self.val | val.val} }
+
+// Returns the set of bits present in both the receiver and the input.
+func (self Flags[T]) Intersect(val Flags[T]) Flags[T] { return Flags[T]{self.val & val.val} }
+
+// Returns the number of set bits.
+func (self Flags[T]) Len() int { return bits.OnesCount64(uint64(self.val)) }
+
+// Returns the sorted names of set bits, ignoring unnamed bits.
+func (self Flags[T]) Names() []string {
+	names, _ := flagsNames(self.val)
+	return names
+}
+
+// True if every set bit is named in `T.FlagNames`.
+func (self Flags[T]) IsValid() bool { return flagsValid(self.val) == nil }
+
+// Used only for compile-time checks.
+type flagsExample uint8
+
+func (flagsExample) FlagNames() []string { return nil }
+
+func flagsNameList[T Flagger]() []string {
+	var zero T
+	return zero.FlagNames()
+}
+
+func flagsMask[T Flagger]() (out T) {
+	for ind, name := range flagsNameList[T]() {
+		if len(name) > 0 {
+			out |= T(1) << ind
+		}
+	}
+	return
+}
+
+// Returns the sorted names of set bits and the remaining unnamed bits.
+func flagsNames[T Flagger](val T) ([]string, T) {
+	if val == 0 {
+		return nil, 0
+	}
+
+	var out []string
+	for ind, name := range flagsNameList[T]() {
+		bit := T(1) << ind
+		if len(name) > 0 && bit != 0 && val&bit != 0 {
+			out = append(out, name)
+			val &^= bit
+		}
+	}
+	sort.Strings(out)
+	return out, val
+}
+
+func flagsParseElem[T Flagger](src string) (T, error) {
+	var zero T
+
+	if isIntString(src) {
+		num, err := strconv.ParseUint(src, 10, 64)
+		val := T(num)
+		if err != nil || uint64(val) != num {
+			return zero, errFlagBits(src, zero)
+		}
+		return val, flagsValid(val)
+	}
+
+	if len(src) > 0 {
+		for ind, name := range flagsNameList[T]() {
+			if name != src {
+				continue
+			}
+			// Zero if the index exceeds the bit width.
+			bit := T(1) << ind
+			if bit == 0 {
+				return zero, errFlagWidth(src, zero, ind)
+			}
+			return bit, nil
+		}
+	}
+	return zero, errFlagName(src, zero, flagsAllowed[T]())
+}
+
+func flagsValid[T Flagger](val T) error {
+	rest := val &^ flagsMask[T]()
+	if rest != 0 {
+		return errFlagBits(strconv.FormatUint(uint64(rest), 10), val)
+	}
+	return nil
+}
+
+func flagsAllowed[T Flagger]() string {
+	var out []string
+	for ind, name := range flagsNameList[T]() {
+		if len(name) > 0 && T(1)<<ind != 0 {
+			out = append(out, strconv.Quote(name))
+		}
+	}
+	return strings.Join(out, `, `)
+}
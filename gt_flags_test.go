@@ -0,0 +1,207 @@
+package gt_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/mitranim/gt"
+)
+
+type Perm uint16
+
+const (
+	PermRead Perm = 1 << iota
+	PermWrite
+	_
+	PermAdmin
+)
+
+var permNames = []string{`read`, `write`, ``, `admin`}
+
+func (Perm) FlagNames() []string { return permNames }
+
+// Has more names than bits. The last name has no bit.
+type NarrowFlag uint8
+
+var narrowFlagNames = []string{`b0`, `b1`, `b2`, `b3`, `b4`, `b5`, `b6`, `b7`, `b8`}
+
+func (NarrowFlag) FlagNames() []string { return narrowFlagNames }
+
+func TestNullFlags_common(t *testing.T) {
+	var (
+		primZero    = any(nil)
+		primNonZero = uint64(9)
+		textZero    = ``
+		textNonZero = `admin,read`
+		jsonZero    = bytesNull
+		jsonNonZero = []byte(`["admin","read"]`)
+		zero        = gt.NullFlags[Perm]{}
+		nonZero     = gt.NullFlagsOf(PermRead, PermAdmin)
+		dec         = new(gt.NullFlags[Perm])
+	)
+
+	eq(true, zero.IsNull())
+	eq(false, nonZero.IsNull())
+
+	testAny(t, primZero, primNonZero, textZero, textNonZero, jsonZero, jsonNonZero, zero, nonZero, dec)
+}
+
+func TestFlags(t *testing.T) {
+	// Unlike other non-nullable types, the zero value is encoded as an empty
+	// string, which must decode back into zero, so `testAny` doesn't apply.
+	t.Run(`encoding`, func(t *testing.T) {
+		zero := gt.Flags[Perm]{}
+		nonZero := gt.FlagsOf(PermRead, PermAdmin)
+
+		eq(false, zero.IsNull())
+		eq(``, zero.String())
+		eq(`admin,read`, nonZero.String())
+		eq([]byte{}, tryByteSlice(zero.MarshalText()))
+		eq([]byte(`admin,read`), tryByteSlice(nonZero.MarshalText()))
+		eq(`[]`, string(jsonBytes(zero)))
+		eq(`["admin","read"]`, string(jsonBytes(nonZero)))
+		eq(uint64(0), tryInterface(zero.Value()))
+		eq(uint64(9), tryInterface(nonZero.Value()))
+
+		dec := nonZero
+		try(dec.Parse(``))
+		eq(zero, dec)
+		try(dec.UnmarshalText([]byte(`admin,read`)))
+		eq(nonZero, dec)
+		try(dec.UnmarshalJSON([]byte(`[]`)))
+		eq(zero, dec)
+		try(dec.Scan(uint64(9)))
+		eq(nonZero, dec)
+		dec.Zero()
+		eq(zero, dec)
+	})
+
+	t.Run(`ops`, func(t *testing.T) {
+		val := gt.FlagsOf(PermRead)
+
+		eq(true, val.Has(PermRead))
+		eq(false, val.Has(PermWrite))
+		eq(false, val.Has(0))
+		eq(false, val.Has(PermRead|PermWrite))
+		eq(true, val.HasAny(PermRead|PermWrite))
+
+		eq(gt.FlagsOf(PermRead, PermWrite), val.Add(PermWrite))
+		eq(gt.Flags[Perm]{}, val.Clear(PermRead))
+		eq(val, val.Clear(PermAdmin))
+		eq(gt.FlagsOf(PermWrite), val.Toggle(PermRead|PermWrite))
+
+		eq(
+			gt.FlagsOf(PermRead, PermWrite, PermAdmin),
+			gt.FlagsOf(PermRead, PermAdmin).Union(gt.FlagsOf(PermWrite)),
+		)
+		eq(
+			gt.FlagsOf(PermAdmin),
+			gt.FlagsOf(PermRead, PermAdmin).Intersect(gt.FlagsOf(PermWrite, PermAdmin)),
+		)
+
+		eq(2, gt.FlagsOf(PermRead, PermAdmin).Len())
+		eq([]string{`admin`, `write`}, gt.FlagsOf(PermWrite, PermAdmin).Names())
+		eq(true, gt.NullFlagsOf(PermRead).Add(PermAdmin).Has(PermAdmin))
+	})
+
+	t.Run(`Parse`, func(t *testing.T) {
+		test := func(exp Perm, src string) {
+			t.Helper()
+			eq(exp, gt.ParseFlags[Perm](src).Val())
+		}
+
+		test(0, ``)
+		test(PermRead, `read`)
+		test(PermRead|PermAdmin, `read,admin`)
+		test(PermRead|PermAdmin, ` admin , read `)
+		test(PermRead|PermAdmin, `9`)
+		test(PermRead|PermWrite, `read,2`)
+		test(PermRead, `read,read`)
+
+		var val gt.Flags[Perm]
+		fail(val.Parse(`Read`))
+		fail(val.Parse(`read,`))
+		fail(val.Parse(`4`))
+		fail(val.Parse(`65536`))
+		fail(val.Parse(`-1`))
+		eq(gt.Flags[Perm]{}, val)
+
+		panics(t, `unrecognized flag "exec" for gt_test.Perm, expected one of: "read", "write", "admin"`, func() {
+			gt.ParseFlags[Perm](`exec`)
+		})
+		panics(t, `unrecognized bits 4 for gt_test.Perm`, func() {
+			gt.FlagsOf(Perm(5))
+		})
+	})
+
+	t.Run(`names exceeding bit width`, func(t *testing.T) {
+		eq(NarrowFlag(1<<7), gt.ParseFlags[NarrowFlag](`b7`).Val())
+		eq(`b0,b7`, gt.FlagsOf(NarrowFlag(1), NarrowFlag(1<<7)).String())
+
+		var val gt.Flags[NarrowFlag]
+		fail(val.Parse(`b8`))
+		fail(val.Parse(`b0,b8`))
+		fail(json.Unmarshal([]byte(`["b8"]`), &val))
+		eq(gt.Flags[NarrowFlag]{}, val)
+
+		panics(t, `flag "b8" for gt_test.NarrowFlag has index 8 which exceeds the bit width of the type`, func() {
+			gt.ParseFlags[NarrowFlag](`b8`)
+		})
+	})
+
+	t.Run(`unnamed bits`, func(t *testing.T) {
+		val := gt.Flags[Perm]{}.Add(PermRead | 4 | 16)
+		eq(false, val.IsValid())
+		eq(`read,20`, val.String())
+		eq(`["read",20]`, string(jsonBytes(val)))
+		eq(`[20]`, string(jsonBytes(gt.Flags[Perm]{}.Add(4|16))))
+	})
+
+	t.Run(`json`, func(t *testing.T) {
+		test := func(exp Perm, src string) {
+			t.Helper()
+			var val gt.NullFlags[Perm]
+			try(json.Unmarshal([]byte(src), &val))
+			eq(exp, val.Val())
+		}
+
+		test(0, `null`)
+		test(0, `[]`)
+		test(PermAdmin, `8`)
+		test(PermRead|PermAdmin, `["read","admin"]`)
+		test(PermRead|PermWrite, `["read",2]`)
+
+		var val gt.Flags[Perm]
+		fail(json.Unmarshal([]byte(`["exec"]`), &val))
+		fail(json.Unmarshal([]byte(`["2"]`), &val))
+		fail(json.Unmarshal([]byte(`[""]`), &val))
+		fail(json.Unmarshal([]byte(`[4]`), &val))
+		fail(json.Unmarshal([]byte(`"read"`), &val))
+		fail(json.Unmarshal([]byte(`1.5`), &val))
+	})
+
+	t.Run(`Scan`, func(t *testing.T) {
+		test := func(exp Perm, src any) {
+			t.Helper()
+			var val gt.NullFlags[Perm]
+			try(val.Scan(src))
+			eq(exp, val.Val())
+		}
+
+		test(0, nil)
+		test(PermRead|PermAdmin, int64(9))
+		test(PermRead|PermAdmin, uint64(9))
+		test(PermWrite, []byte(`write`))
+		test(PermWrite, PermWrite)
+		test(PermWrite, gt.FlagsOf(PermWrite))
+		test(PermWrite, gt.NullInt(2))
+		test(0, gt.NullInt(0))
+
+		var val gt.Flags[Perm]
+		fail(val.Scan(nil))
+		fail(val.Scan(int64(-1)))
+		fail(val.Scan(int64(4)))
+		fail(val.Scan(uint64(1 << 20)))
+		fail(val.Scan(true))
+	})
+}
@@ -0,0 +1,142 @@
+package gt
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+/*
+Converts a domain name to its ASCII form as used in DNS: lowercases the domain,
+and encodes every non-ASCII label in Punycode (RFC 3492) with the "xn--" prefix.
+This is a subset of IDNA ToASCII (RFC 5891, UTS 46): it doesn't perform Unicode
+normalization or check bidi and contextual rules, which are only relevant for
+unusual inputs. Labels which are already ASCII are only lowercased.
+*/
+func idnaToAscii(src string) string {
+	src = strings.ToLower(src)
+	if isAscii(src) {
+		return src
+	}
+
+	buf := make([]byte, 0, len(src)*2)
+	for ind, label := range strings.Split(src, `.`) {
+		if ind > 0 {
+			buf = append(buf, '.')
+		}
+		if isAscii(label) {
+			buf = append(buf, label...)
+		} else {
+			buf = append(buf, `xn--`...)
+			buf = punycodeAppend(buf, label)
+		}
+	}
+	return bytesString(buf)
+}
+
+func isAscii(src string) bool {
+	for ind := 0; ind < len(src); ind++ {
+		if src[ind] >= utf8.RuneSelf {
+			return false
+		}
+	}
+	return true
+}
+
+const (
+	punyBase        = 36
+	punyTmin        = 1
+	punyTmax        = 26
+	punySkew        = 38
+	punyDamp        = 700
+	punyInitialBias = 72
+	punyInitialN    = 128
+)
+
+// Appends the Punycode encoding (RFC 3492) of the given string, without prefix.
+func punycodeAppend(buf []byte, src string) []byte {
+	var total int
+	var basic int
+	for _, char := range src {
+		total++
+		if char < utf8.RuneSelf {
+			buf = append(buf, byte(char))
+			basic++
+		}
+	}
+	if basic > 0 {
+		buf = append(buf, '-')
+	}
+
+	next := punyInitialN
+	bias := punyInitialBias
+	delta := 0
+
+	for handled := basic; handled < total; {
+		least := int(utf8.MaxRune) + 1
+		for _, char := range src {
+			if int(char) >= next && int(char) < least {
+				least = int(char)
+			}
+		}
+
+		delta += (least - next) * (handled + 1)
+		next = least
+
+		for _, char := range src {
+			if int(char) < next {
+				delta++
+				continue
+			}
+			if int(char) != next {
+				continue
+			}
+
+			rem := delta
+			for k := punyBase; ; k += punyBase {
+				thr := k - bias
+				if thr < punyTmin {
+					thr = punyTmin
+				} else if thr > punyTmax {
+					thr = punyTmax
+				}
+				if rem < thr {
+					break
+				}
+				buf = append(buf, punyDigit(thr+(rem-thr)%(punyBase-thr)))
+				rem = (rem - thr) / (punyBase - thr)
+			}
+			buf = append(buf, punyDigit(rem))
+
+			bias = punyAdapt(delta, handled+1, handled == basic)
+			delta = 0
+			handled++
+		}
+
+		delta++
+		next++
+	}
+	return buf
+}
+
+func punyAdapt(delta, count int, first bool) int {
+	if first {
+		delta /= punyDamp
+	} else {
+		delta /= 2
+	}
+	delta += delta / count
+
+	var k int
+	for delta > ((punyBase-punyTmin)*punyTmax)/2 {
+		delta /= punyBase - punyTmin
+		k += punyBase
+	}
+	return k + (punyBase-punyTmin+1)*delta/(delta+punySkew)
+}
+
+func punyDigit(val int) byte {
+	if val < 26 {
+		return byte('a' + val)
+	}
+	return byte('0' + val - 26)
+}
@@ -0,0 +1,173 @@
+package gt
+
+import (
+	"math"
+	"strings"
+	"time"
+)
+
+/*
+Sentinel values corresponding to Postgres `infinity` and `-infinity` in
+`timestamp`, `timestamptz` and `date` columns, useful for open-ended validity
+periods. Encoded as "infinity" and "-infinity" in text, SQL and JSON. For other
+JSON encodings, see `gt.InfinityJson`. Infinity is later than any other timestamp or
+date, and negative infinity is earlier, which means the regular comparison
+methods and functions such as `gt.NullTimeLess` order them correctly.
+Arithmetic such as `.AddDate` preserves infinity. Use `.IsInfinite` to detect
+them; they're not considered zero or null.
+
+Should not be modified.
+*/
+var (
+	NullTimeInfinity    = NullTime(time.Unix(timeInfinitySec, 999999999).UTC())
+	NullTimeNegInfinity = NullTime(time.Unix(timeNegInfinitySec, 0).UTC())
+	NullDateInfinity    = NullDate{math.MaxInt, time.December, 31}
+	NullDateNegInfinity = NullDate{math.MinInt, time.January, 1}
+)
+
+// Seconds from 0001-01-01 to 1970-01-01, same as in the "time" package.
+const unixToInternal = (1969*365 + 1969/4 - 1969/100 + 1969/400) * 24 * 60 * 60
+
+// Unix seconds of `gt.NullTimeInfinity` and `gt.NullTimeNegInfinity`.
+const (
+	timeInfinitySec    = math.MaxInt64 - unixToInternal
+	timeNegInfinitySec = math.MinInt64
+)
+
+/*
+Determines how infinite `gt.NullTime` and `gt.NullDate` are encoded in JSON.
+Their own `.MarshalJSON` always uses `gt.InfinityJsonString`. Other modes are
+opt-in, either via `gt.TimeEncoder.Infinity`, or directly:
+
+	gt.InfinityJsonNull.NullTime(val)
+	gt.InfinityJsonBound.NullDate(val)
+
+Decoding always accepts "infinity" and "-infinity".
+*/
+type InfinityJson byte
+
+const (
+	// Encode as JSON strings "infinity" and "-infinity". Default.
+	InfinityJsonString InfinityJson = iota
+
+	// Encode as JSON `null`. Not reversible.
+	InfinityJsonNull
+
+	/**
+	Encode as the latest and earliest values representable in RFC 3339, such as
+	"9999-12-31T23:59:59.999999999Z" and "0000-01-01T00:00:00Z", or "9999-12-31"
+	and "0000-01-01" for dates. Not reversible, but understood by clients
+	unaware of infinity.
+	*/
+	InfinityJsonBound
+)
+
+/*
+Same as `gt.NullTime.MarshalJSON`, but encodes infinity according to the mode.
+*/
+func (self InfinityJson) NullTime(val NullTime) []byte {
+	if sign := val.infinitySign(); sign != 0 {
+		return self.json(sign, timeInfinityBound)
+	}
+	out, _ := val.MarshalJSON()
+	return out
+}
+
+/*
+Same as `gt.NullDate.MarshalJSON`, but encodes infinity according to the mode.
+*/
+func (self InfinityJson) NullDate(val NullDate) []byte {
+	if sign := val.infinitySign(); sign != 0 {
+		return self.json(sign, dateInfinityBound)
+	}
+	out, _ := val.MarshalJSON()
+	return out
+}
+
+func (self InfinityJson) json(sign int, bound func(int) string) []byte {
+	var str string
+
+	switch self {
+	case InfinityJsonNull:
+		return bytesNull
+	case InfinityJsonBound:
+		str = bound(sign)
+	default:
+		str = infinityString(sign)
+	}
+
+	buf := make([]byte, 0, len(str)+2)
+	buf = append(buf, '"')
+	buf = append(buf, str...)
+	buf = append(buf, '"')
+	return buf
+}
+
+const (
+	infinityStr    = `infinity`
+	negInfinityStr = `-infinity`
+)
+
+/*
+Returns 1 for "infinity" or "+infinity", -1 for "-infinity", 0 otherwise.
+Case-insensitive, like in Postgres.
+*/
+func infinitySign(src string) int {
+	if len(src) < len(infinityStr) || len(src) > len(negInfinityStr) {
+		return 0
+	}
+
+	sign := 1
+	switch src[0] {
+	case '-':
+		sign = -1
+		src = src[1:]
+	case '+':
+		src = src[1:]
+	}
+
+	if strings.EqualFold(src, infinityStr) {
+		return sign
+	}
+	return 0
+}
+
+/*
+Called by most methods of `gt.NullTime`, so it avoids `time.Time.Equal`.
+Comparing Unix seconds is cheap and fails for all other timestamps, since
+infinities are at the extremes of the representable range.
+*/
+func timeInfinitySign(val time.Time) int {
+	switch val.Unix() {
+	case timeInfinitySec:
+		if val.Nanosecond() == 999999999 {
+			return 1
+		}
+	case timeNegInfinitySec:
+		if val.Nanosecond() == 0 {
+			return -1
+		}
+	}
+	return 0
+}
+
+func infinityString(sign int) string {
+	if sign < 0 {
+		return negInfinityStr
+	}
+	return infinityStr
+}
+
+func timeInfinityBound(sign int) string {
+	if sign < 0 {
+		return `0000-01-01T00:00:00Z`
+	}
+	return `9999-12-31T23:59:59.999999999Z`
+}
+
+func dateInfinityBound(sign int) string {
+	if sign < 0 {
+		return `0000-01-01`
+	}
+	return `9999-12-31`
+}
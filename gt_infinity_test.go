@@ -0,0 +1,116 @@
+package gt_test
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/mitranim/gt"
+)
+
+func TestNullTime_infinity(t *testing.T) {
+	inf, neg := gt.NullTimeInfinity, gt.NullTimeNegInfinity
+
+	eq(true, inf.IsInfinite())
+	eq(true, neg.IsInfinite())
+	eq(false, inf.IsNull())
+	eq(false, neg.IsNull())
+	eq(false, gt.NullTime{}.IsInfinite())
+	eq(false, gt.NullTimeUTC(9999, 12, 31, 23, 59, 59, 999999999).IsInfinite())
+
+	eq(inf, gt.ParseNullTime(`infinity`))
+	eq(inf, gt.ParseNullTime(`+Infinity`))
+	eq(neg, gt.ParseNullTime(`-INFINITY`))
+	fail(new(gt.NullTime).Parse(`infinityy`))
+
+	eq(`infinity`, inf.String())
+	eq(`-infinity`, neg.String())
+	eq(`infinity`, tryInterface(inf.Value()))
+	eq(`gt.NullTimeNegInfinity`, neg.GoString())
+
+	var val gt.NullTime
+	try(val.Scan([]byte(`-infinity`)))
+	eq(neg, val)
+	try(val.UnmarshalJSON([]byte(`"infinity"`)))
+	eq(inf, val)
+
+	eq(inf, inf.AddDate(1, 2, 3))
+	eq(neg, neg.Add(time.Hour))
+	eq(inf, inf.AddInterval(gt.DateInterval(1, 0, 0)))
+
+	eq(gt.NullDateInfinity, inf.NullDate())
+	eq(gt.NullDateNegInfinity, neg.NullDate())
+
+	eq(true, gt.NullTimeLess(neg, gt.NullTime{}, gt.NullTimeUTC(2024, 1, 1, 0, 0, 0, 0), inf))
+	eq(false, gt.NullTimeLess(gt.NullTimeUTC(2024, 1, 1, 0, 0, 0, 0), neg))
+	eq(false, gt.NullTimeLess(inf, inf))
+	eq(true, gt.NullTimeLessOrEqual(inf, inf))
+
+	eq(`infinity`, gt.NullTimeUtcMilli(inf).String())
+}
+
+func TestNullDate_infinity(t *testing.T) {
+	inf, neg := gt.NullDateInfinity, gt.NullDateNegInfinity
+
+	eq(true, inf.IsInfinite())
+	eq(true, neg.IsInfinite())
+	eq(false, inf.IsNull())
+	eq(false, gt.NullDateFrom(2024, 1, 1).IsInfinite())
+
+	eq(inf, gt.ParseNullDate(`infinity`))
+	eq(neg, gt.ParseNullDate(`-infinity`))
+	eq(`infinity`, inf.String())
+	eq(`-infinity`, neg.String())
+	eq(`-infinity`, tryInterface(neg.Value()))
+	eq(`gt.NullDateInfinity`, inf.GoString())
+
+	var val gt.NullDate
+	try(val.Scan(`infinity`))
+	eq(inf, val)
+	try(val.Scan(gt.NullTimeNegInfinity))
+	eq(neg, val)
+
+	eq(inf, inf.AddDate(1, 0, 0))
+	eq(neg, neg.AddInterval(gt.DateInterval(-1, 0, 0)))
+	eq(gt.NullTimeInfinity, inf.NullTimeUTC())
+
+	eq(true, gt.NullDateLess(neg, gt.NullDateFrom(-100, 1, 1), gt.NullDateFrom(9999, 12, 31), inf))
+	eq(false, gt.NullDateLess(inf, gt.NullDateFrom(2024, 1, 1)))
+	eq(0, inf.Compare(inf))
+	eq(-1, neg.Compare(inf))
+
+	eq(gt.NullTimeInfinity.Time(), inf.TimeUTC())
+	eq(gt.NullTimeNegInfinity.Time(), neg.TimeUTC())
+	eq(math.MaxInt, gt.NullDateFrom(2024, 1, 1).DaysUntil(inf))
+	eq(math.MinInt, gt.NullDateFrom(2024, 1, 1).DaysUntil(neg))
+	eq(math.MaxInt, neg.DaysUntil(inf))
+	eq(math.MinInt, inf.DaysUntil(neg))
+	eq(0, inf.DaysUntil(inf))
+	eq(inf, inf.StartOfMonth())
+	eq(neg, neg.EndOfMonth())
+	eq(inf, inf.StartOfYear())
+}
+
+func TestInfinityJson(t *testing.T) {
+	eq(jsonBytes(`infinity`), jsonBytes(gt.NullTimeInfinity))
+	eq(jsonBytes(`-infinity`), jsonBytes(gt.NullDateNegInfinity))
+	eq(jsonBytes(`infinity`), gt.InfinityJsonString.NullTime(gt.NullTimeInfinity))
+	eq(jsonBytes(`-infinity`), gt.InfinityJsonString.NullDate(gt.NullDateNegInfinity))
+
+	eq(bytesNull, gt.InfinityJsonNull.NullTime(gt.NullTimeInfinity))
+	eq(bytesNull, gt.InfinityJsonNull.NullDate(gt.NullDateInfinity))
+
+	eq(jsonBytes(`9999-12-31T23:59:59.999999999Z`), gt.InfinityJsonBound.NullTime(gt.NullTimeInfinity))
+	eq(jsonBytes(`0000-01-01T00:00:00Z`), gt.InfinityJsonBound.NullTime(gt.NullTimeNegInfinity))
+	eq(jsonBytes(`9999-12-31`), gt.InfinityJsonBound.NullDate(gt.NullDateInfinity))
+	eq(jsonBytes(`0000-01-01`), gt.InfinityJsonBound.NullDate(gt.NullDateNegInfinity))
+
+	// Finite values are encoded as usual.
+	eq(bytesNull, gt.InfinityJsonBound.NullTime(gt.NullTime{}))
+	eq(jsonBytes(`2024-01-02`), gt.InfinityJsonBound.NullDate(gt.NullDateFrom(2024, 1, 2)))
+	eq(jsonBytes(`2024-01-02T03:04:05Z`), gt.InfinityJsonNull.NullTime(gt.NullTimeUTC(2024, 1, 2, 3, 4, 5, 0)))
+
+	enc := gt.TimeEncoder{UTC: true, Infinity: gt.InfinityJsonBound}
+	eq(jsonBytes(`9999-12-31T23:59:59.999999999Z`), enc.Json(gt.NullTimeInfinity))
+	eq(jsonBytes(`infinity`), gt.TimeEncoder{UTC: true}.Json(gt.NullTimeInfinity))
+}
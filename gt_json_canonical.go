@@ -0,0 +1,218 @@
+package gt
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+	"unicode/utf16"
+	"unicode/utf8"
+)
+
+/*
+Implements the JSON Canonicalization Scheme (RFC 8785). Uses the streaming
+tokenizer of `json.Decoder`, rather than decoding into maps, in order to detect
+duplicate keys, which must be rejected.
+*/
+func jsonCanonical(src []byte) ([]byte, error) {
+	dec := json.NewDecoder(bytes.NewReader(src))
+	dec.UseNumber()
+
+	buf, err := jsonCanonicalAppend(make([]byte, 0, len(src)), dec)
+	if err != nil {
+		return nil, err
+	}
+
+	// Anything other than a clean EOF, including a syntax error, means trailing
+	// data after the value.
+	_, err = dec.Token()
+	if err != io.EOF {
+		return nil, errInvalidJson(src)
+	}
+	return buf, nil
+}
+
+func jsonCanonicalAppend(buf []byte, dec *json.Decoder) ([]byte, error) {
+	tok, err := dec.Token()
+	if err != nil {
+		return buf, err
+	}
+
+	switch tok := tok.(type) {
+	case json.Delim:
+		if tok == '[' {
+			return jsonCanonicalArray(buf, dec)
+		}
+		return jsonCanonicalObject(buf, dec)
+
+	case string:
+		return jsonAppendString(buf, tok), nil
+
+	case json.Number:
+		return jsonCanonicalNumber(buf, tok)
+
+	case bool:
+		return strconv.AppendBool(buf, tok), nil
+
+	default:
+		return append(buf, `null`...), nil
+	}
+}
+
+func jsonCanonicalArray(buf []byte, dec *json.Decoder) ([]byte, error) {
+	var err error
+	buf = append(buf, '[')
+
+	for ind := 0; dec.More(); ind++ {
+		if ind > 0 {
+			buf = append(buf, ',')
+		}
+		buf, err = jsonCanonicalAppend(buf, dec)
+		if err != nil {
+			return buf, err
+		}
+	}
+
+	_, err = dec.Token()
+	return append(buf, ']'), err
+}
+
+type jsonCanonicalEntry struct {
+	key  []uint16
+	text []byte
+}
+
+/*
+Members are encoded into separate buffers and sorted by the UTF-16 code units of
+their keys, as required by RFC 8785.
+*/
+func jsonCanonicalObject(buf []byte, dec *json.Decoder) ([]byte, error) {
+	var entries []jsonCanonicalEntry
+	keys := map[string]struct{}{}
+
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return buf, err
+		}
+
+		key, _ := tok.(string)
+		_, ok := keys[key]
+		if ok {
+			return buf, errJsonDuplicateKey(key)
+		}
+		keys[key] = struct{}{}
+
+		text := jsonAppendString(nil, key)
+		text = append(text, ':')
+		text, err = jsonCanonicalAppend(text, dec)
+		if err != nil {
+			return buf, err
+		}
+
+		entries = append(entries, jsonCanonicalEntry{utf16.Encode([]rune(key)), text})
+	}
+
+	_, err := dec.Token()
+	if err != nil {
+		return buf, err
+	}
+
+	sort.Slice(entries, func(one, two int) bool {
+		return jsonCanonicalLess(entries[one].key, entries[two].key)
+	})
+
+	buf = append(buf, '{')
+	for ind, entry := range entries {
+		if ind > 0 {
+			buf = append(buf, ',')
+		}
+		buf = append(buf, entry.text...)
+	}
+	return append(buf, '}'), nil
+}
+
+func jsonCanonicalLess(one, two []uint16) bool {
+	for ind := 0; ind < len(one) && ind < len(two); ind++ {
+		if one[ind] != two[ind] {
+			return one[ind] < two[ind]
+		}
+	}
+	return len(one) < len(two)
+}
+
+/*
+Escapes only quotes, backslashes, and control characters, using the short forms
+where available and lowercase hex otherwise. Everything else, including
+non-ASCII characters, is written as-is.
+*/
+func jsonAppendString(buf []byte, src string) []byte {
+	buf = append(buf, '"')
+
+	for ind := 0; ind < len(src); ind++ {
+		char := src[ind]
+
+		switch char {
+		case '"', '\\':
+			buf = append(buf, '\\', char)
+		case '\b':
+			buf = append(buf, '\\', 'b')
+		case '\t':
+			buf = append(buf, '\\', 't')
+		case '\n':
+			buf = append(buf, '\\', 'n')
+		case '\f':
+			buf = append(buf, '\\', 'f')
+		case '\r':
+			buf = append(buf, '\\', 'r')
+		default:
+			if char < 0x20 {
+				buf = append(buf, '\\', 'u', '0', '0', hexLower[char>>4], hexLower[char&0xf])
+			} else if char < utf8.RuneSelf {
+				buf = append(buf, char)
+			} else {
+				_, size := utf8.DecodeRuneInString(src[ind:])
+				buf = append(buf, src[ind:ind+size]...)
+				ind += size - 1
+			}
+		}
+	}
+
+	return append(buf, '"')
+}
+
+/*
+Formats numbers like ECMAScript `Number.prototype.toString`, which is also the
+algorithm used by `json.Marshal` for `float64`.
+*/
+func jsonCanonicalNumber(buf []byte, src json.Number) ([]byte, error) {
+	val, err := strconv.ParseFloat(string(src), 64)
+	if err != nil {
+		return buf, err
+	}
+	if val == 0 {
+		return append(buf, '0'), nil
+	}
+
+	format := byte('f')
+	abs := math.Abs(val)
+	if abs < 1e-6 || abs >= 1e21 {
+		format = 'e'
+	}
+
+	size := len(buf)
+	buf = strconv.AppendFloat(buf, val, format, -1, 64)
+
+	// Convert "e-07" to "e-7".
+	if format == 'e' {
+		num := buf[size:]
+		end := len(num)
+		if end >= 4 && num[end-4] == 'e' && num[end-3] == '-' && num[end-2] == '0' {
+			num[end-2] = num[end-1]
+			buf = buf[:len(buf)-1]
+		}
+	}
+	return buf, nil
+}
@@ -0,0 +1,246 @@
+package gt
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	r "reflect"
+)
+
+/*
+Shortcut: parses successfully or panics. Should be used only in root scope. When
+error handling is relevant, use `.Parse`.
+*/
+func ParseJsonOf[T any](src string) (val JsonOf[T]) {
+	try(val.Parse(src))
+	return
+}
+
+/*
+Wraps an arbitrary value of `T`, which is stored as JSON in text and SQL, and
+embedded directly in JSON. Intended for Postgres `json` and `jsonb` columns
+holding structured data, avoiding `.Scan` and `.Value` boilerplate for every
+such type. Example:
+
+	type Settings struct {
+		Theme string `json:"theme"`
+	}
+
+	type User struct {
+		Settings gt.JsonOf[Settings]     `json:"settings" db:"settings"`
+		Extra    gt.NullJsonOf[[]string] `json:"extra"    db:"extra"`
+	}
+
+The zero value contains the zero value of `T`, which is encoded via
+`json.Marshal`, for example as `{}` for structs or `null` for nil pointers and
+maps. For a variant where zero is considered null, see `gt.NullJsonOf`.
+
+Just like `gt.Raw`, empty inputs and JSON `null` zero the receiver. Decoding
+always starts from the zero value of `T`, rather than merging into the existing
+value.
+*/
+type JsonOf[T any] struct{ Val T }
+
+var (
+	_ = Encodable(JsonOf[any]{})
+	_ = Decodable((*JsonOf[any])(nil))
+)
+
+// Implement `gt.Zeroable`. True if the underlying value is the zero value of `T`.
+func (self JsonOf[T]) IsZero() bool { return jsonOfIsZero(&self.Val) }
+
+// Implement `gt.Nullable`. Always `false`.
+func (self JsonOf[T]) IsNull() bool { return false }
+
+/*
+Implement `gt.Getter`, returning the underlying value of `T` as-is. Unlike most
+types in this package, `.Value` doesn't use `.Get`, and encodes the value as
+JSON instead.
+*/
+func (self JsonOf[T]) Get() any { return self.Val }
+
+// Implement `gt.PtrGetter`, returning `*T`.
+func (self *JsonOf[T]) GetPtr() any { return &self.Val }
+
+// Implement `gt.Setter`, using `.Scan`. Panics on error.
+func (self *JsonOf[T]) Set(src any) { try(self.Scan(src)) }
+
+// Implement `gt.Zeroer`, zeroing the receiver.
+func (self *JsonOf[T]) Zero() {
+	if self != nil {
+		*self = JsonOf[T]{}
+	}
+}
+
+/*
+Implement `fmt.Stringer`, returning the JSON representation of the underlying
+value. Panics if the value can't be encoded.
+*/
+func (self JsonOf[T]) String() string { return bytesString(self.AppendTo(nil)) }
+
+/*
+Implement `gt.Parser`. If the input is empty or represents JSON `null`, zeroes
+the receiver. Otherwise decodes JSON into a zero value of `T`.
+*/
+func (self *JsonOf[T]) Parse(src string) error {
+	return self.UnmarshalJSON([]byte(src))
+}
+
+/*
+Implement `gt.AppenderTo`, appending the JSON representation of the underlying
+value. Panics if the value can't be encoded.
+*/
+func (self JsonOf[T]) AppendTo(buf []byte) []byte {
+	val, err := self.MarshalJSON()
+	try(err)
+	return append(buf, val...)
+}
+
+// Implement `encoding.TextMarhaler`, returning the same representation as `.MarshalJSON`.
+func (self JsonOf[T]) MarshalText() ([]byte, error) { return self.MarshalJSON() }
+
+// Implement `encoding.TextUnmarshaler`, using the same algorithm as `.Parse`.
+func (self *JsonOf[T]) UnmarshalText(src []byte) error {
+	return self.UnmarshalJSON(src)
+}
+
+// Implement `json.Marshaler`, using `json.Marshal` on the underlying value.
+func (self JsonOf[T]) MarshalJSON() ([]byte, error) { return json.Marshal(self.Val) }
+
+/*
+Implement `json.Unmarshaler`. If the input is empty or represents JSON `null`,
+zeroes the receiver. Otherwise decodes JSON into a zero value of `T`, and
+modifies the receiver only on success.
+*/
+func (self *JsonOf[T]) UnmarshalJSON(src []byte) error {
+	if isJsonEmpty(src) {
+		self.Zero()
+		return nil
+	}
+
+	val, err := jsonOfDecode[T](src)
+	if err == nil {
+		self.Val = val
+	}
+	return err
+}
+
+/*
+Implement `driver.Valuer`, returning the JSON representation of the underlying
+value as `[]byte`, like `gt.Raw`.
+*/
+func (self JsonOf[T]) Value() (driver.Value, error) { return self.MarshalJSON() }
+
+/*
+Implement `sql.Scanner`, converting an arbitrary input to `gt.JsonOf` and
+modifying the receiver. Acceptable inputs:
+
+  - `nil`                   -> use `.Zero`
+  - `string`                -> use `.Parse`
+  - `[]byte`                -> use `.UnmarshalText`
+  - convertible to `string` -> use `.Parse`
+  - convertible to `[]byte` -> use `.UnmarshalText`
+  - `T`                     -> assign
+  - `gt.JsonOf[T]`          -> assign
+  - `gt.NullJsonOf[T]`      -> assign
+  - `gt.Getter`             -> scan underlying value
+
+If `T` is itself a string or byte slice, strings and byte slices are still
+decoded as JSON. If `T` is an interface such as `any`, inputs convertible to
+text, such as `gt.Raw` and `gt.NullJson`, are also decoded as JSON rather than
+assigned.
+*/
+func (self *JsonOf[T]) Scan(src any) error {
+	switch src := src.(type) {
+	case nil:
+		self.Zero()
+		return nil
+
+	case string:
+		return self.Parse(src)
+
+	case []byte:
+		return self.UnmarshalText(src)
+
+	case JsonOf[T]:
+		*self = src
+		return nil
+
+	case NullJsonOf[T]:
+		self.Val = src.Val
+		return nil
+
+	default:
+		ok, err := jsonOfScan(self, &self.Val, src)
+		if ok {
+			return err
+		}
+
+		val, ok := get(src)
+		if ok {
+			return self.Scan(val)
+		}
+		return errScanType(self, src)
+	}
+}
+
+// Converts to `gt.NullJsonOf`.
+func (self JsonOf[T]) NullJsonOf() NullJsonOf[T] { return NullJsonOf[T](self) }
+
+func jsonOfIsInterface[T any]() bool {
+	return r.TypeOf((*T)(nil)).Elem().Kind() == r.Interface
+}
+
+// Uses a pointer to avoid boxing the value.
+func jsonOfIsZero[T any](val *T) bool { return r.ValueOf(val).Elem().IsZero() }
+
+func jsonOfDecode[T any](src []byte) (val T, err error) {
+	err = json.Unmarshal(src, &val)
+	if err != nil {
+		err = errJsonDecode(src, val, err)
+	}
+	return
+}
+
+/*
+Scans `T` or text into the target. When `T` is an interface such as `any`,
+every input is `T`, so text must be checked first. Otherwise `T` is checked
+first, because `T` may itself be convertible to text. Returns false for other
+inputs.
+*/
+func jsonOfScan[T any](tar Decodable, ptr *T, src any) (bool, error) {
+	iface := jsonOfIsInterface[T]()
+
+	if !iface {
+		val, ok := src.(T)
+		if ok {
+			*ptr = val
+			return true, nil
+		}
+	}
+
+	ok, err := jsonOfScanText(tar, src)
+	if ok || !iface {
+		return ok, err
+	}
+
+	val, ok := src.(T)
+	if ok {
+		*ptr = val
+	}
+	return ok, nil
+}
+
+/*
+Handles types convertible to `string` or `[]byte`, such as `gt.Raw` and
+`gt.NullJson`, which are decoded as JSON. Returns false for other inputs.
+*/
+func jsonOfScanText(tar Decodable, src any) (bool, error) {
+	val := r.ValueOf(src)
+	if val.Kind() == r.String {
+		return true, tar.Parse(val.String())
+	}
+	if val.Kind() == r.Slice && val.Type().Elem().Kind() == r.Uint8 {
+		return true, tar.UnmarshalText(val.Bytes())
+	}
+	return false, nil
+}
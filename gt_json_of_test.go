@@ -0,0 +1,176 @@
+package gt_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/mitranim/gt"
+)
+
+type JsonInner struct {
+	One string `json:"one"`
+	Two []int  `json:"two,omitempty"`
+}
+
+func TestNullJsonOf_common(t *testing.T) {
+	var (
+		primZero    = any(nil)
+		primNonZero = []byte(`{"one":"val","two":[10,20]}`)
+		textZero    = ``
+		textNonZero = `{"one":"val","two":[10,20]}`
+		jsonZero    = bytesNull
+		jsonNonZero = []byte(`{"one":"val","two":[10,20]}`)
+		zero        = gt.NullJsonOf[JsonInner]{}
+		nonZero     = gt.NullJsonOf[JsonInner]{JsonInner{`val`, []int{10, 20}}}
+		dec         = new(gt.NullJsonOf[JsonInner])
+	)
+
+	eq(true, zero.IsNull())
+	eq(false, nonZero.IsNull())
+
+	testAny(t, primZero, primNonZero, textZero, textNonZero, jsonZero, jsonNonZero, zero, nonZero, dec)
+}
+
+func TestJsonOf(t *testing.T) {
+	t.Run(`encoding`, func(t *testing.T) {
+		zero := gt.JsonOf[JsonInner]{}
+		val := gt.JsonOf[JsonInner]{JsonInner{`val`, []int{10}}}
+
+		eq(true, zero.IsZero())
+		eq(false, zero.IsNull())
+		eq(false, val.IsZero())
+
+		eq(`{"one":""}`, zero.String())
+		eq(`{"one":"val","two":[10]}`, val.String())
+		eq([]byte(`{"one":"val","two":[10]}`), tryByteSlice(val.MarshalText()))
+		eq([]byte(`{"one":""}`), tryInterface(zero.Value()))
+		eq([]byte(`{"one":"val","two":[10]}`), tryInterface(val.Value()))
+		eq(JsonInner{`val`, []int{10}}, val.Get())
+
+		eq(`null`, gt.JsonOf[*JsonInner]{}.String())
+		eq(`null`, gt.JsonOf[map[string]int]{}.String())
+		eq(`0`, gt.JsonOf[int]{}.String())
+		eq(`"str"`, gt.JsonOf[string]{`str`}.String())
+	})
+
+	t.Run(`embedded in JSON`, func(t *testing.T) {
+		type Outer struct {
+			Inner gt.JsonOf[JsonInner]     `json:"inner"`
+			Null  gt.NullJsonOf[JsonInner] `json:"null"`
+			List  gt.NullJsonOf[[]string]  `json:"list"`
+		}
+
+		eq(
+			`{"inner":{"one":""},"null":null,"list":null}`,
+			string(jsonBytes(Outer{})),
+		)
+
+		src := Outer{
+			Inner: gt.JsonOf[JsonInner]{JsonInner{One: `one`}},
+			Null:  gt.NullJsonOf[JsonInner]{JsonInner{One: `two`}},
+			List:  gt.NullJsonOf[[]string]{[]string{`three`}},
+		}
+		text := `{"inner":{"one":"one"},"null":{"one":"two"},"list":["three"]}`
+		eq(text, string(jsonBytes(src)))
+
+		var out Outer
+		try(json.Unmarshal([]byte(text), &out))
+		eq(src, out)
+
+		try(json.Unmarshal([]byte(`{"inner":null,"null":null,"list":null}`), &out))
+		eq(Outer{}, out)
+	})
+
+	t.Run(`decoding`, func(t *testing.T) {
+		var val gt.JsonOf[JsonInner]
+
+		try(val.Parse(`{"one": "val"}`))
+		eq(JsonInner{One: `val`}, val.Val)
+
+		try(val.Parse(``))
+		eq(true, val.IsZero())
+
+		try(val.Scan([]byte(`{"two": [1]}`)))
+		eq(JsonInner{Two: []int{1}}, val.Val)
+
+		// Decoding starts from zero, rather than merging.
+		try(val.Scan(`{"one": "val"}`))
+		eq(JsonInner{One: `val`}, val.Val)
+
+		try(val.Scan([]byte(`null`)))
+		eq(true, val.IsZero())
+
+		try(val.Scan(gt.Raw(`{"one": "raw"}`)))
+		eq(JsonInner{One: `raw`}, val.Val)
+
+		try(val.Scan(gt.ParseNullJson(`{"one": "json"}`)))
+		eq(JsonInner{One: `json`}, val.Val)
+
+		try(val.Scan(JsonInner{One: `inner`}))
+		eq(JsonInner{One: `inner`}, val.Val)
+
+		try(val.Scan(gt.NullJsonOf[JsonInner]{JsonInner{One: `null`}}))
+		eq(JsonInner{One: `null`}, val.Val)
+
+		try(val.Scan(nil))
+		eq(true, val.IsZero())
+
+		// Strings are still decoded as JSON.
+		var str gt.JsonOf[string]
+		try(str.Scan(`"quoted"`))
+		eq(`quoted`, str.Val)
+		fail(str.Scan(`unquoted`))
+
+		// With an interface type, text is decoded rather than assigned.
+		var iface gt.JsonOf[any]
+		try(iface.Scan(gt.Raw(`{"one": "raw"}`)))
+		eq(any(map[string]any{`one`: `raw`}), iface.Val)
+		try(iface.Scan(gt.ParseNullJson(`[10]`)))
+		eq(any([]any{float64(10)}), iface.Val)
+		try(iface.Scan(gt.NullJson(nil)))
+		eq(true, iface.IsZero())
+		try(iface.Scan(JsonInner{One: `inner`}))
+		eq(any(JsonInner{One: `inner`}), iface.Val)
+		try(iface.Scan(gt.JsonOf[any]{Val: `json`}))
+		eq(any(`json`), iface.Val)
+
+		var null gt.NullJsonOf[any]
+		try(null.Scan(gt.Raw(`{"one": "raw"}`)))
+		eq(any(map[string]any{`one`: `raw`}), null.Val)
+		try(null.Scan(gt.ParseNullJson(`"json"`)))
+		eq(any(`json`), null.Val)
+		try(null.Scan(gt.NullJson(nil)))
+		eq(true, null.IsNull())
+	})
+
+	t.Run(`invalid`, func(t *testing.T) {
+		val := gt.JsonOf[JsonInner]{JsonInner{One: `prev`}}
+
+		fail(val.Parse(`{`))
+		fail(val.Parse(`[]`))
+		fail(val.Scan([]byte(`{"one": 10}`)))
+		fail(val.Scan(int64(10)))
+		eq(JsonInner{One: `prev`}, val.Val)
+
+		panics(t, `unable to decode "{" into gt_test.JsonInner`, func() {
+			gt.ParseJsonOf[JsonInner](`{`)
+		})
+	})
+
+	t.Run(`GetPtr`, func(t *testing.T) {
+		var val gt.JsonOf[JsonInner]
+		val.GetPtr().(*JsonInner).One = `ptr`
+		eq(`ptr`, val.Val.One)
+
+		var null gt.NullJsonOf[[]int]
+		try(json.Unmarshal([]byte(`[1, 2]`), null.GetPtr()))
+		eq([]int{1, 2}, null.Val)
+	})
+
+	t.Run(`conversion`, func(t *testing.T) {
+		val := gt.ParseJsonOf[[]int](`[1]`)
+		eq(gt.NullJsonOf[[]int]{[]int{1}}, val.NullJsonOf())
+		eq(val, val.NullJsonOf().JsonOf())
+		eq(gt.NullJsonOf[[]int]{[]int{1}}, gt.ParseNullJsonOf[[]int](`[1]`))
+	})
+}
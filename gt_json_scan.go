@@ -0,0 +1,439 @@
+package gt
+
+import (
+	"bytes"
+	"encoding/json"
+	"strconv"
+	"strings"
+)
+
+/*
+Minimal JSON scanner used by `gt.Raw` for JSON Pointer lookup and merge patches.
+Locates values by skipping over their text without decoding them. Assumes
+mostly valid JSON: on malformed input, returns failure rather than panicking,
+but doesn't reject every possible syntax error.
+*/
+func jsonSkipSpace(src []byte, pos int) int {
+	for pos < len(src) && jsonIsSpace(src[pos]) {
+		pos++
+	}
+	return pos
+}
+
+func jsonIsSpace(char byte) bool {
+	return char == ' ' || char == '\t' || char == '\n' || char == '\r'
+}
+
+// Returns the end of the value starting at the given position, or -1.
+func jsonSkipValue(src []byte, pos int) int {
+	if pos >= len(src) {
+		return -1
+	}
+
+	switch src[pos] {
+	case '"':
+		return jsonSkipString(src, pos)
+
+	case '{', '[':
+		depth := 0
+		for pos < len(src) {
+			switch src[pos] {
+			case '"':
+				pos = jsonSkipString(src, pos)
+				if pos < 0 {
+					return -1
+				}
+				continue
+			case '{', '[':
+				depth++
+			case '}', ']':
+				depth--
+				if depth == 0 {
+					return pos + 1
+				}
+			}
+			pos++
+		}
+		return -1
+
+	default:
+		start := pos
+		for pos < len(src) && !jsonIsDelim(src[pos]) {
+			pos++
+		}
+		if pos == start {
+			return -1
+		}
+		return pos
+	}
+}
+
+// Returns the end of the string starting at the given position, or -1.
+func jsonSkipString(src []byte, pos int) int {
+	for pos++; pos < len(src); pos++ {
+		switch src[pos] {
+		case '\\':
+			pos++
+		case '"':
+			return pos + 1
+		}
+	}
+	return -1
+}
+
+func jsonIsDelim(char byte) bool {
+	return jsonIsSpace(char) || char == ',' || char == ':' || char == '}' || char == ']'
+}
+
+// Returns the value with surrounding whitespace removed, or nil if invalid.
+func jsonTrimValue(src []byte) []byte {
+	start := jsonSkipSpace(src, 0)
+	end := jsonSkipValue(src, start)
+	if end < 0 {
+		return nil
+	}
+	return src[start:end:end]
+}
+
+/*
+Iterates over members of an object or elements of an array, without decoding
+them. Usage:
+
+	var iter jsonIter
+	if iter.init(src, pos) {
+		for iter.next() {}
+	}
+	if iter.failed {}
+*/
+type jsonIter struct {
+	src    []byte
+	obj    bool
+	pos    int    // Start of next member, or separator, or closing delimiter.
+	prev   int    // End of previous value, or position after opening delimiter.
+	head   int    // Start of current member, including key.
+	key    []byte // Quoted key of current member. Nil for arrays.
+	start  int    // Start of current value.
+	end    int    // End of current value.
+	count  int    // Number of visited members.
+	failed bool
+}
+
+func (self *jsonIter) init(src []byte, pos int) bool {
+	if pos >= len(src) || (src[pos] != '{' && src[pos] != '[') {
+		return false
+	}
+	*self = jsonIter{
+		src:  src,
+		obj:  src[pos] == '{',
+		pos:  jsonSkipSpace(src, pos+1),
+		prev: pos + 1,
+	}
+	return true
+}
+
+// Advances to the next member. Returns false at the end or on malformed input.
+func (self *jsonIter) next() bool {
+	if self.failed {
+		return false
+	}
+
+	src := self.src
+	pos := self.pos
+	if pos >= len(src) {
+		return self.fail()
+	}
+	if src[pos] == self.closer() {
+		return false
+	}
+
+	if self.count > 0 {
+		if src[pos] != ',' {
+			return self.fail()
+		}
+		self.prev = self.end
+		pos = jsonSkipSpace(src, pos+1)
+	}
+
+	self.head = pos
+	if self.obj {
+		end := -1
+		if pos < len(src) && src[pos] == '"' {
+			end = jsonSkipString(src, pos)
+		}
+		if end < 0 {
+			return self.fail()
+		}
+
+		self.key = src[pos:end]
+		pos = jsonSkipSpace(src, end)
+		if pos >= len(src) || src[pos] != ':' {
+			return self.fail()
+		}
+		pos = jsonSkipSpace(src, pos+1)
+	}
+
+	self.start = pos
+	self.end = jsonSkipValue(src, pos)
+	if self.end < 0 {
+		return self.fail()
+	}
+
+	self.pos = jsonSkipSpace(src, self.end)
+	self.count++
+	return true
+}
+
+func (self *jsonIter) fail() bool {
+	self.failed = true
+	return false
+}
+
+func (self *jsonIter) closer() byte {
+	if self.obj {
+		return '}'
+	}
+	return ']'
+}
+
+/*
+Span to remove when deleting the current member, including one adjacent
+separator and whitespace, so that the remaining text stays valid JSON.
+*/
+func (self *jsonIter) cut() (int, int) {
+	if self.pos < len(self.src) && self.src[self.pos] == ',' {
+		return self.head, jsonSkipSpace(self.src, self.pos+1)
+	}
+	return self.prev, self.end
+}
+
+/*
+Seeks the member matching the given unescaped JSON Pointer token, in the
+container starting at the given position. On success, the iterator is
+positioned at the member. Otherwise, if the input is valid, the iterator is
+positioned at the closing delimiter, and `.count` is the total member count.
+*/
+func (self *jsonIter) seek(src []byte, pos int, tok string) bool {
+	if !self.init(src, pos) {
+		return self.fail()
+	}
+
+	if self.obj {
+		for self.next() {
+			if jsonKeyEqual(self.key, tok) {
+				return true
+			}
+		}
+		return false
+	}
+
+	// Invalid indexes and "-" never match, but the iteration must still reach the
+	// end, which is used for appending.
+	ind, ok := jsonPointerIndex(tok)
+	if !ok {
+		ind = -1
+	}
+	for self.next() {
+		if self.count-1 == ind {
+			return true
+		}
+	}
+	return false
+}
+
+// Compares a quoted key, as found in JSON, with an unquoted string.
+func jsonKeyEqual(key []byte, str string) bool {
+	if bytes.IndexByte(key, '\\') < 0 {
+		return bytesString(cutJsonStr(key)) == str
+	}
+	return jsonKeyString(key) == str
+}
+
+// Decodes a quoted key, as found in JSON. Avoids decoding when unescaped.
+func jsonKeyString(key []byte) string {
+	if bytes.IndexByte(key, '\\') < 0 {
+		return string(cutJsonStr(key))
+	}
+	var out string
+	_ = json.Unmarshal(key, &out)
+	return out
+}
+
+/*
+Parses a JSON Pointer (RFC 6901) array index: a decimal number without leading
+zeros. Doesn't support "-", which must be handled by callers.
+*/
+func jsonPointerIndex(tok string) (int, bool) {
+	if len(tok) <= 0 || (len(tok) > 1 && tok[0] == '0') || !isIntString(tok) ||
+		tok[0] == '-' || tok[0] == '+' {
+		return 0, false
+	}
+	val, err := strconv.Atoi(tok)
+	return val, err == nil
+}
+
+// Unescapes a JSON Pointer token, replacing "~1" with "/" and "~0" with "~".
+func jsonPointerUnescape(src string) (string, bool) {
+	if strings.IndexByte(src, '~') < 0 {
+		return src, true
+	}
+
+	var buf strings.Builder
+	buf.Grow(len(src))
+
+	for ind := 0; ind < len(src); ind++ {
+		char := src[ind]
+		if char != '~' {
+			buf.WriteByte(char)
+			continue
+		}
+
+		ind++
+		if ind >= len(src) {
+			return ``, false
+		}
+
+		switch src[ind] {
+		case '0':
+			buf.WriteByte('~')
+		case '1':
+			buf.WriteByte('/')
+		default:
+			return ``, false
+		}
+	}
+	return buf.String(), true
+}
+
+/*
+Returns the span of the value referenced by the JSON Pointer, which must be
+empty or start with "/".
+*/
+func jsonPointerFind(src []byte, ptr string) (int, int, bool) {
+	start := jsonSkipSpace(src, 0)
+	end := jsonSkipValue(src, start)
+	if end < 0 {
+		return 0, 0, false
+	}
+	if len(ptr) <= 0 {
+		return start, end, true
+	}
+	if ptr[0] != '/' {
+		return 0, 0, false
+	}
+
+	var iter jsonIter
+	for rest := ptr[1:]; ; {
+		tok := rest
+		ind := strings.IndexByte(rest, '/')
+		if ind >= 0 {
+			tok, rest = rest[:ind], rest[ind+1:]
+		}
+
+		tok, ok := jsonPointerUnescape(tok)
+		if !ok || !iter.seek(src, start, tok) {
+			return 0, 0, false
+		}
+
+		start, end = iter.start, iter.end
+		if ind < 0 {
+			return start, end, true
+		}
+	}
+}
+
+// True if the JSON Pointer is empty, or starts with "/" and has valid escapes.
+func jsonPointerValid(ptr string) bool {
+	if len(ptr) <= 0 {
+		return true
+	}
+	if ptr[0] != '/' {
+		return false
+	}
+	for ind := 0; ind < len(ptr); ind++ {
+		if ptr[ind] == '~' && (ind+1 >= len(ptr) || (ptr[ind+1] != '0' && ptr[ind+1] != '1')) {
+			return false
+		}
+	}
+	return true
+}
+
+// Splits a non-empty JSON Pointer into the parent pointer and the last token.
+func jsonPointerSplit(ptr string) (string, string, bool) {
+	if len(ptr) <= 0 || ptr[0] != '/' {
+		return ``, ``, false
+	}
+	ind := strings.LastIndexByte(ptr, '/')
+	tok, ok := jsonPointerUnescape(ptr[ind+1:])
+	return ptr[:ind], tok, ok
+}
+
+/*
+Applies a JSON Merge Patch (RFC 7386). Both inputs must be single trimmed JSON
+values; the target may be nil, which represents a missing value. Members of the
+target keep their order, and new members are appended in patch order. Values
+which aren't affected by the patch are copied as-is.
+*/
+func jsonMergePatch(buf, tar, patch []byte) []byte {
+	if len(patch) <= 0 || patch[0] != '{' {
+		return append(buf, patch...)
+	}
+
+	var patchIter jsonIter
+	patchIter.init(patch, 0)
+
+	buf = append(buf, '{')
+	count := 0
+
+	var tarIter jsonIter
+	if len(tar) > 0 && tar[0] == '{' {
+		tarIter.init(tar, 0)
+
+		for tarIter.next() {
+			key := jsonKeyString(tarIter.key)
+			val := tar[tarIter.start:tarIter.end]
+
+			var iter jsonIter
+			if iter.seek(patch, 0, key) {
+				if isJsonNull(patch[iter.start:iter.end]) {
+					continue
+				}
+				buf = jsonMergeKey(buf, &count, tarIter.key)
+				buf = jsonMergePatch(buf, val, patch[iter.start:iter.end])
+				continue
+			}
+
+			buf = jsonMergeKey(buf, &count, tarIter.key)
+			buf = append(buf, val...)
+		}
+	}
+
+	for patchIter.next() {
+		val := patch[patchIter.start:patchIter.end]
+		if isJsonNull(val) {
+			continue
+		}
+
+		if tarIter.src != nil {
+			var iter jsonIter
+			if iter.seek(tar, 0, jsonKeyString(patchIter.key)) {
+				continue
+			}
+		}
+
+		buf = jsonMergeKey(buf, &count, patchIter.key)
+		buf = jsonMergePatch(buf, nil, val)
+	}
+
+	return append(buf, '}')
+}
+
+func jsonMergeKey(buf []byte, count *int, key []byte) []byte {
+	if *count > 0 {
+		buf = append(buf, ',')
+	}
+	*count++
+	buf = append(buf, key...)
+	return append(buf, ':')
+}
+
+func isJsonNull(val []byte) bool { return bytes.Equal(val, bytesNull) }
@@ -0,0 +1,138 @@
+package gt
+
+import "database/sql/driver"
+
+/*
+Shortcut: parses successfully or panics. Should be used only in root scope. When
+error handling is relevant, use `.Parse`.
+*/
+func ParseLaxNullDate(src string) (val LaxNullDate) {
+	try(val.Parse(src))
+	return
+}
+
+/*
+Variant of `gt.NullDate` that decodes text, JSON and SQL input via
+`gt.LaxTimeParser`, accepting any timestamp format supported by
+`gt.LaxNullTime` and taking the civil date in the timestamp's own location.
+Encoding is identical to `gt.NullDate`.
+*/
+type LaxNullDate NullDate
+
+var (
+	_ = Encodable(LaxNullDate{})
+	_ = Decodable((*LaxNullDate)(nil))
+)
+
+// Implement `gt.Zeroable`. Equivalent to `reflect.ValueOf(self).IsZero()`.
+func (self LaxNullDate) IsZero() bool { return NullDate(self).IsZero() }
+
+// Implement `gt.Nullable`. True if zero.
+func (self LaxNullDate) IsNull() bool { return self.IsZero() }
+
+// Implement `gt.Getter`. Same as `gt.NullDate.Get`.
+func (self LaxNullDate) Get() any { return NullDate(self).Get() }
+
+// Implement `gt.Setter`, using `.Scan`. Panics on error.
+func (self *LaxNullDate) Set(src any) { try(self.Scan(src)) }
+
+// Implement `gt.Zeroer`, zeroing the receiver.
+func (self *LaxNullDate) Zero() { (*NullDate)(self).Zero() }
+
+// Implement `fmt.Stringer`. Same as `gt.NullDate.String`.
+func (self LaxNullDate) String() string { return NullDate(self).String() }
+
+/*
+Implement `gt.Parser`. If the input is empty, zeroes the receiver. Otherwise
+uses `gt.LaxTimeParser`.
+*/
+func (self *LaxNullDate) Parse(src string) error {
+	return (*NullDate)(self).ParseWith(src, laxTimeParser)
+}
+
+// Implement `gt.AppenderTo`, using the same representation as `.String`.
+func (self LaxNullDate) AppendTo(buf []byte) []byte {
+	return NullDate(self).AppendTo(buf)
+}
+
+// Implement `encoding.TextMarhaler`. Same as `gt.NullDate.MarshalText`.
+func (self LaxNullDate) MarshalText() ([]byte, error) {
+	return NullDate(self).MarshalText()
+}
+
+// Implement `encoding.TextUnmarshaler`, using the same algorithm as `.Parse`.
+func (self *LaxNullDate) UnmarshalText(src []byte) error {
+	return self.Parse(bytesString(src))
+}
+
+// Implement `json.Marshaler`. Same as `gt.NullDate.MarshalJSON`.
+func (self LaxNullDate) MarshalJSON() ([]byte, error) {
+	return NullDate(self).MarshalJSON()
+}
+
+/*
+Implement `json.Unmarshaler`. If the input is empty or represents JSON `null`,
+zeroes the receiver. Otherwise parses a JSON string or an integer, using the
+same algorithm as `.Parse`.
+*/
+func (self *LaxNullDate) UnmarshalJSON(src []byte) error {
+	if isJsonEmpty(src) {
+		self.Zero()
+		return nil
+	}
+
+	if isJsonStr(src) {
+		return self.UnmarshalText(cutJsonStr(src))
+	}
+
+	if isIntString(bytesString(src)) {
+		return self.UnmarshalText(src)
+	}
+
+	return errJsonString(src, self)
+}
+
+// Implement `driver.Valuer`, using `.Get`.
+func (self LaxNullDate) Value() (driver.Value, error) {
+	return self.Get(), nil
+}
+
+/*
+Implement `sql.Scanner`, converting an arbitrary input to `gt.LaxNullDate` and
+modifying the receiver. Acceptable inputs:
+
+  - `nil`            -> use `.Zero`
+  - `string`         -> use `.Parse`
+  - `[]byte`         -> use `.UnmarshalText`
+  - `int64`          -> Unix timestamp, see `gt.TimeParser`
+  - `gt.LaxNullDate` -> assign
+  - other            -> use `gt.NullDate.Scan`
+*/
+func (self *LaxNullDate) Scan(src any) error {
+	switch src := src.(type) {
+	case string:
+		return self.Parse(src)
+
+	case []byte:
+		return self.UnmarshalText(src)
+
+	case int64:
+		var val LaxNullTime
+		err := val.Scan(src)
+		if err != nil {
+			return err
+		}
+		*self = LaxNullDate(val.NullTime().NullDate())
+		return nil
+
+	case LaxNullDate:
+		*self = src
+		return nil
+
+	default:
+		return (*NullDate)(self).Scan(src)
+	}
+}
+
+// Free cast to `gt.NullDate`.
+func (self LaxNullDate) NullDate() NullDate { return NullDate(self) }
@@ -0,0 +1,147 @@
+package gt
+
+import (
+	"database/sql/driver"
+	"time"
+)
+
+/*
+Shortcut: parses successfully or panics. Should be used only in root scope. When
+error handling is relevant, use `.Parse`.
+*/
+func ParseLaxNullTime(src string) (val LaxNullTime) {
+	try(val.Parse(src))
+	return
+}
+
+/*
+Variant of `gt.NullTime` that decodes text, JSON and SQL input via
+`gt.LaxTimeParser`, accepting formats such as RFC 1123, RFC 850, Postgres
+`timestamp` and `timestamptz`, and Unix timestamps in seconds, milliseconds or
+microseconds. Encoding is identical to `gt.NullTime`. Suitable for fields
+populated from third party sources with inconsistent formats.
+*/
+type LaxNullTime NullTime
+
+var (
+	_ = Encodable(LaxNullTime{})
+	_ = Decodable((*LaxNullTime)(nil))
+)
+
+// Implement `gt.Zeroable`. Same as `gt.NullTime.IsZero`.
+func (self LaxNullTime) IsZero() bool { return NullTime(self).IsZero() }
+
+// Implement `gt.Nullable`. True if zero.
+func (self LaxNullTime) IsNull() bool { return self.IsZero() }
+
+// Implement `gt.PtrGetter`, returning `*time.Time`.
+func (self *LaxNullTime) GetPtr() any { return (*time.Time)(self) }
+
+// Implement `gt.Getter`. If zero, returns `nil`, otherwise returns `time.Time`.
+func (self LaxNullTime) Get() any { return NullTime(self).Get() }
+
+// Implement `gt.Setter`, using `.Scan`. Panics on error.
+func (self *LaxNullTime) Set(src any) { try(self.Scan(src)) }
+
+// Implement `gt.Zeroer`, zeroing the receiver.
+func (self *LaxNullTime) Zero() { (*NullTime)(self).Zero() }
+
+// Implement `fmt.Stringer`. Same as `gt.NullTime.String`.
+func (self LaxNullTime) String() string { return NullTime(self).String() }
+
+/*
+Implement `gt.Parser`. If the input is empty, zeroes the receiver. Otherwise
+uses `gt.LaxTimeParser`.
+*/
+func (self *LaxNullTime) Parse(src string) error {
+	return (*NullTime)(self).ParseWith(src, laxTimeParser)
+}
+
+// Implement `gt.AppenderTo`, using the same representation as `.String`.
+func (self LaxNullTime) AppendTo(buf []byte) []byte {
+	return NullTime(self).AppendTo(buf)
+}
+
+// Implement `encoding.TextMarhaler`. Same as `gt.NullTime.MarshalText`.
+func (self LaxNullTime) MarshalText() ([]byte, error) {
+	return NullTime(self).MarshalText()
+}
+
+// Implement `encoding.TextUnmarshaler`, using the same algorithm as `.Parse`.
+func (self *LaxNullTime) UnmarshalText(src []byte) error {
+	return self.Parse(bytesString(src))
+}
+
+// Implement `json.Marshaler`. Same as `gt.NullTime.MarshalJSON`.
+func (self LaxNullTime) MarshalJSON() ([]byte, error) {
+	return NullTime(self).MarshalJSON()
+}
+
+/*
+Implement `json.Unmarshaler`. If the input is empty or represents JSON `null`,
+zeroes the receiver. Otherwise parses a JSON string or an integer, using the
+same algorithm as `.Parse`.
+*/
+func (self *LaxNullTime) UnmarshalJSON(src []byte) error {
+	if isJsonEmpty(src) {
+		self.Zero()
+		return nil
+	}
+
+	if isJsonStr(src) {
+		return self.UnmarshalText(cutJsonStr(src))
+	}
+
+	if isIntString(bytesString(src)) {
+		return self.UnmarshalText(src)
+	}
+
+	return errJsonString(src, self)
+}
+
+// Implement `driver.Valuer`, using `.Get`.
+func (self LaxNullTime) Value() (driver.Value, error) {
+	return self.Get(), nil
+}
+
+/*
+Implement `sql.Scanner`, converting an arbitrary input to `gt.LaxNullTime` and
+modifying the receiver. Acceptable inputs:
+
+  - `nil`            -> use `.Zero`
+  - `string`         -> use `.Parse`
+  - `[]byte`         -> use `.UnmarshalText`
+  - `int64`          -> Unix timestamp, see `gt.TimeParser`
+  - `gt.LaxNullTime` -> assign
+  - other            -> use `gt.NullTime.Scan`
+*/
+func (self *LaxNullTime) Scan(src any) error {
+	switch src := src.(type) {
+	case string:
+		return self.Parse(src)
+
+	case []byte:
+		return self.UnmarshalText(src)
+
+	case int64:
+		val, err := laxTimeParser.unix(src)
+		if err != nil {
+			return err
+		}
+		*self = LaxNullTime(val)
+		return nil
+
+	case LaxNullTime:
+		*self = src
+		return nil
+
+	default:
+		return (*NullTime)(self).Scan(src)
+	}
+}
+
+// Free cast to `gt.NullTime`.
+func (self LaxNullTime) NullTime() NullTime { return NullTime(self) }
+
+// Free cast to `time.Time`.
+func (self LaxNullTime) Time() time.Time { return time.Time(self) }
@@ -0,0 +1,157 @@
+package gt
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"strings"
+)
+
+/*
+Shortcut: parses successfully or panics. Should be used only in root scope. When
+error handling is relevant, use `.Parse`.
+*/
+func ParseLaxTer(src string) (val LaxTer) {
+	try(val.Parse(src))
+	return
+}
+
+/*
+Parses the input into `gt.Ter`, accepting common spellings of booleans used by
+HTML forms, query strings, environment variables and Postgres text output.
+Case-insensitive:
+
+  - ""                                   = gt.TerNull
+  - "false", "f", "no",  "n", "off", "0" = gt.TerFalse
+  - "true",  "t", "yes", "y", "on",  "1" = gt.TerTrue
+
+Used by `gt.LaxTer`. For the strict format, use `gt.Ter.Parse`.
+*/
+func ParseTerLax(src string) (Ter, error) {
+	if len(src) <= 0 {
+		return TerNull, nil
+	}
+	for _, val := range terLaxFalse {
+		if strings.EqualFold(src, val) {
+			return TerFalse, nil
+		}
+	}
+	for _, val := range terLaxTrue {
+		if strings.EqualFold(src, val) {
+			return TerTrue, nil
+		}
+	}
+	return TerNull, fmt.Errorf(`[gt] unable to parse ternary: expected empty string or boolean such as "true", "false", "on", "off", "yes", "no", "1", "0", got %q`, src)
+}
+
+var (
+	terLaxFalse = []string{`false`, `f`, `no`, `n`, `off`, `0`}
+	terLaxTrue  = []string{`true`, `t`, `yes`, `y`, `on`, `1`}
+)
+
+/*
+Variant of `gt.Ter` that decodes text, JSON and SQL input via `gt.ParseTerLax`,
+accepting spellings such as "on", "yes", "1", "t", in any case. JSON input may
+also be a number 0 or 1. Encoding is identical to `gt.Ter`. Suitable for fields
+decoded from HTML forms, query strings, or third party sources.
+*/
+type LaxTer Ter
+
+var (
+	_ = Encodable(LaxTer(0))
+	_ = Decodable((*LaxTer)(nil))
+)
+
+// Implement `gt.Zeroable`. True if `gt.TerNull`.
+func (self LaxTer) IsZero() bool { return Ter(self).IsZero() }
+
+// Implement `gt.Nullable`. True if `gt.TerNull`.
+func (self LaxTer) IsNull() bool { return self.IsZero() }
+
+// Implement `gt.Getter`. Same as `gt.Ter.Get`.
+func (self LaxTer) Get() any { return Ter(self).Get() }
+
+// Implement `gt.Setter`, using `.Scan`. Panics on error.
+func (self *LaxTer) Set(src any) { try(self.Scan(src)) }
+
+// Implement `gt.Zeroer`, zeroing the receiver.
+func (self *LaxTer) Zero() { (*Ter)(self).Zero() }
+
+// Implement `fmt.Stringer`. Same as `gt.Ter.String`.
+func (self LaxTer) String() string { return Ter(self).String() }
+
+/*
+Implement `gt.Parser`. If the input is empty, zeroes the receiver. Otherwise
+uses `gt.ParseTerLax`.
+*/
+func (self *LaxTer) Parse(src string) error {
+	val, err := ParseTerLax(src)
+	if err != nil {
+		return err
+	}
+	*self = LaxTer(val)
+	return nil
+}
+
+// Implement `gt.AppenderTo`, using the same representation as `.String`.
+func (self LaxTer) AppendTo(buf []byte) []byte { return Ter(self).AppendTo(buf) }
+
+// Implement `encoding.TextMarhaler`. Same as `gt.Ter.MarshalText`.
+func (self LaxTer) MarshalText() ([]byte, error) { return Ter(self).MarshalText() }
+
+// Implement `encoding.TextUnmarshaler`, using the same algorithm as `.Parse`.
+func (self *LaxTer) UnmarshalText(src []byte) error {
+	return self.Parse(bytesString(src))
+}
+
+// Implement `json.Marshaler`. Same as `gt.Ter.MarshalJSON`.
+func (self LaxTer) MarshalJSON() ([]byte, error) { return Ter(self).MarshalJSON() }
+
+/*
+Implement `json.Unmarshaler`. If the input is empty or represents JSON `null`,
+zeroes the receiver. Otherwise accepts JSON booleans, numbers 0 and 1, and
+strings decoded via `.Parse`.
+*/
+func (self *LaxTer) UnmarshalJSON(src []byte) error {
+	if isJsonEmpty(src) {
+		self.Zero()
+		return nil
+	}
+	if isJsonStr(src) {
+		return self.UnmarshalText(cutJsonStr(src))
+	}
+	return self.UnmarshalText(src)
+}
+
+// Implement `driver.Valuer`, using `.Get`.
+func (self LaxTer) Value() (driver.Value, error) {
+	return self.Get(), nil
+}
+
+/*
+Implement `sql.Scanner`, converting an arbitrary input to `gt.LaxTer` and
+modifying the receiver. Acceptable inputs:
+
+  - `string`    -> use `.Parse`
+  - `[]byte`    -> use `.UnmarshalText`
+  - `gt.LaxTer` -> assign
+  - other       -> use `gt.Ter.Scan`
+*/
+func (self *LaxTer) Scan(src any) error {
+	switch src := src.(type) {
+	case string:
+		return self.Parse(src)
+
+	case []byte:
+		return self.UnmarshalText(src)
+
+	case LaxTer:
+		*self = src
+		return nil
+
+	default:
+		return (*Ter)(self).Scan(src)
+	}
+}
+
+// Free cast to `gt.Ter`.
+func (self LaxTer) Ter() Ter { return Ter(self) }
@@ -0,0 +1,89 @@
+package gt_test
+
+import (
+	"testing"
+
+	"github.com/mitranim/gt"
+)
+
+func TestLaxTer_common(t *testing.T) {
+	var (
+		primZero    = any(nil)
+		primNonZero = true
+		textZero    = ``
+		textNonZero = `true`
+		jsonZero    = bytesNull
+		jsonNonZero = []byte(`true`)
+		zero        = gt.LaxTer(gt.TerNull)
+		nonZero     = gt.LaxTer(gt.TerTrue)
+		dec         = new(gt.LaxTer)
+	)
+
+	eq(true, zero.IsNull())
+	eq(false, nonZero.IsNull())
+
+	testAny(t, primZero, primNonZero, textZero, textNonZero, jsonZero, jsonNonZero, zero, nonZero, dec)
+}
+
+func TestParseTerLax(t *testing.T) {
+	test := func(exp gt.Ter, src string) {
+		t.Helper()
+		eq(exp, tryTer(gt.ParseTerLax(src)))
+		eq(gt.LaxTer(exp), gt.ParseLaxTer(src))
+	}
+
+	test(gt.TerNull, ``)
+
+	for _, src := range []string{`false`, `FALSE`, `f`, `F`, `no`, `No`, `n`, `off`, `OFF`, `0`} {
+		test(gt.TerFalse, src)
+	}
+	for _, src := range []string{`true`, `True`, `t`, `T`, `yes`, `YES`, `y`, `on`, `On`, `1`} {
+		test(gt.TerTrue, src)
+	}
+
+	for _, src := range []string{` `, `null`, `2`, `-1`, `ok`, `tru`, ` true`} {
+		_, err := gt.ParseTerLax(src)
+		fail(err)
+	}
+}
+
+func TestLaxTer(t *testing.T) {
+	t.Run(`UnmarshalJSON`, func(t *testing.T) {
+		test := func(exp gt.Ter, src string) {
+			t.Helper()
+			var val gt.LaxTer
+			try(val.UnmarshalJSON([]byte(src)))
+			eq(gt.LaxTer(exp), val)
+		}
+
+		test(gt.TerNull, `null`)
+		test(gt.TerNull, `""`)
+		test(gt.TerFalse, `false`)
+		test(gt.TerTrue, `true`)
+		test(gt.TerFalse, `0`)
+		test(gt.TerTrue, `1`)
+		test(gt.TerTrue, `"on"`)
+		test(gt.TerFalse, `"No"`)
+
+		var val gt.LaxTer
+		fail(val.UnmarshalJSON([]byte(`2`)))
+		fail(val.UnmarshalJSON([]byte(`{}`)))
+	})
+
+	t.Run(`Scan`, func(t *testing.T) {
+		test := func(exp gt.Ter, src any) {
+			t.Helper()
+			var val gt.LaxTer
+			try(val.Scan(src))
+			eq(gt.LaxTer(exp), val)
+		}
+
+		test(gt.TerNull, nil)
+		test(gt.TerTrue, `yes`)
+		test(gt.TerFalse, []byte(`OFF`))
+		test(gt.TerTrue, true)
+		test(gt.TerFalse, int64(0))
+		test(gt.TerTrue, gt.TerTrue)
+		test(gt.TerFalse, gt.LaxTer(gt.TerFalse))
+	})
+}
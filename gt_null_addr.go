@@ -0,0 +1,260 @@
+package gt
+
+import (
+	"database/sql/driver"
+	"net"
+	"net/netip"
+	"strings"
+)
+
+/*
+Shortcut: parses successfully or panics. Should be used only in root scope. When
+error handling is relevant, use `.Parse`.
+*/
+func ParseNullAddr(src string) (val NullAddr) {
+	try(val.Parse(src))
+	return
+}
+
+// Free cast from `netip.Addr`.
+func NullAddrFrom(src netip.Addr) NullAddr { return NullAddr(src) }
+
+/*
+IP address (IPv4 or IPv6) where zero value is considered empty in text, and
+null in JSON and SQL. Wraps `netip.Addr`, which is comparable, allocation-free,
+and much easier to use than `net.IP`. Text and JSON use the same
+representation as `netip.Addr.String`, such as "192.168.0.1", "2001:db8::1", or
+"fe80::1%eth0" with an IPv6 zone.
+
+Compatible with Postgres `inet` and `cidr`. When decoding, also accepts
+addresses with a mask, such as "192.168.0.1/24" from Postgres `inet`, and
+discards the mask. To preserve the mask, use `gt.NullPrefix`. Postgres doesn't
+support IPv6 zones; `.Value` omits the zone.
+*/
+type NullAddr netip.Addr
+
+var (
+	_ = Encodable(NullAddr{})
+	_ = Decodable((*NullAddr)(nil))
+)
+
+// Implement `gt.Zeroable`. True if the address is the zero `netip.Addr`.
+func (self NullAddr) IsZero() bool { return self == NullAddr{} }
+
+// Implement `gt.Nullable`. True if zero.
+func (self NullAddr) IsNull() bool { return self.IsZero() }
+
+/*
+Implement `gt.Getter`. If zero, returns `nil`, otherwise returns the string
+representation without the IPv6 zone, suitable for Postgres `inet`.
+*/
+func (self NullAddr) Get() any {
+	if self.IsNull() {
+		return nil
+	}
+	return self.Addr().WithZone(``).String()
+}
+
+// Implement `gt.Setter`, using `.Scan`. Panics on error.
+func (self *NullAddr) Set(src any) { try(self.Scan(src)) }
+
+// Implement `gt.Zeroer`, zeroing the receiver.
+func (self *NullAddr) Zero() {
+	if self != nil {
+		*self = NullAddr{}
+	}
+}
+
+/*
+Implement `fmt.Stringer`. If zero, returns an empty string. Otherwise formats
+using `netip.Addr.String`.
+*/
+func (self NullAddr) String() string {
+	if self.IsNull() {
+		return ``
+	}
+	return self.Addr().String()
+}
+
+/*
+Implement `gt.Parser`. If the input is empty, zeroes the receiver. Otherwise
+parses the input using `netip.ParseAddr`. If the input has a mask, such as
+"192.168.0.1/24", the mask is validated and discarded.
+*/
+func (self *NullAddr) Parse(src string) (err error) {
+	if len(src) <= 0 {
+		self.Zero()
+		return nil
+	}
+
+	defer errParse(&err, src, `IP address`)
+
+	var val netip.Addr
+	if strings.IndexByte(src, '/') >= 0 {
+		pre, err := netip.ParsePrefix(src)
+		if err != nil {
+			return err
+		}
+		val = pre.Addr()
+	} else {
+		val, err = netip.ParseAddr(src)
+		if err != nil {
+			return err
+		}
+	}
+
+	*self = NullAddr(val)
+	return nil
+}
+
+// Implement `gt.AppenderTo`, using the same representation as `.String`.
+func (self NullAddr) AppendTo(buf []byte) []byte {
+	if self.IsNull() {
+		return buf
+	}
+	return self.Addr().AppendTo(buf)
+}
+
+/*
+Implement `encoding.TextMarhaler`. If zero, returns nil. Otherwise returns the
+same representation as `.String`.
+*/
+func (self NullAddr) MarshalText() ([]byte, error) {
+	if self.IsNull() {
+		return nil, nil
+	}
+	return self.AppendTo(nil), nil
+}
+
+// Implement `encoding.TextUnmarshaler`, using the same algorithm as `.Parse`.
+func (self *NullAddr) UnmarshalText(src []byte) error {
+	return self.Parse(bytesString(src))
+}
+
+/*
+Implement `json.Marshaler`. If zero, returns bytes representing `null`.
+Otherwise returns bytes representing a JSON string with the same text as in
+`.String`.
+*/
+func (self NullAddr) MarshalJSON() ([]byte, error) {
+	if self.IsNull() {
+		return bytesNull, nil
+	}
+
+	buf := make([]byte, 0, 64)
+	buf = append(buf, '"')
+	buf = self.AppendTo(buf)
+	buf = append(buf, '"')
+	return buf, nil
+}
+
+/*
+Implement `json.Unmarshaler`. If the input is empty or represents JSON `null`,
+zeroes the receiver. Otherwise parses a JSON string, using the same algorithm
+as `.Parse`.
+*/
+func (self *NullAddr) UnmarshalJSON(src []byte) error {
+	if isJsonEmpty(src) {
+		self.Zero()
+		return nil
+	}
+	if isJsonStr(src) {
+		return self.UnmarshalText(cutJsonStr(src))
+	}
+	return errJsonString(src, self)
+}
+
+// Implement `driver.Valuer`, using `.Get`.
+func (self NullAddr) Value() (driver.Value, error) {
+	return self.Get(), nil
+}
+
+/*
+Implement `sql.Scanner`, converting an arbitrary input to `gt.NullAddr` and
+modifying the receiver. Acceptable inputs:
+
+  - `nil`           -> use `.Zero`
+  - `string`        -> use `.Parse`
+  - `[]byte`        -> use `.UnmarshalText`
+  - `netip.Addr`    -> assign
+  - `net.IP`        -> convert and assign
+  - `gt.NullAddr`   -> assign
+  - `gt.NullPrefix` -> use address, discarding mask
+  - `gt.Getter`     -> scan underlying value
+*/
+func (self *NullAddr) Scan(src any) error {
+	switch src := src.(type) {
+	case nil:
+		self.Zero()
+		return nil
+
+	case string:
+		return self.Parse(src)
+
+	case []byte:
+		return self.UnmarshalText(src)
+
+	case netip.Addr:
+		*self = NullAddr(src)
+		return nil
+
+	case net.IP:
+		if len(src) <= 0 {
+			self.Zero()
+			return nil
+		}
+		val, ok := netip.AddrFromSlice(src)
+		if !ok {
+			return errScanType(self, src)
+		}
+		if src.To4() != nil {
+			val = val.Unmap()
+		}
+		*self = NullAddr(val)
+		return nil
+
+	case NullAddr:
+		*self = src
+		return nil
+
+	case NullPrefix:
+		*self = src.Addr()
+		return nil
+
+	default:
+		val, ok := get(src)
+		if ok {
+			return self.Scan(val)
+		}
+		return errScanType(self, src)
+	}
+}
+
+// Free cast to `netip.Addr`.
+func (self NullAddr) Addr() netip.Addr { return netip.Addr(self) }
+
+/*
+Returns the prefix that contains only this address, such as "192.168.0.1/32".
+If zero, returns zero.
+*/
+func (self NullAddr) NullPrefix() NullPrefix {
+	if self.IsNull() {
+		return NullPrefix{}
+	}
+	val := self.Addr().WithZone(``)
+	return NullPrefix(netip.PrefixFrom(val, val.BitLen()))
+}
+
+// True if the address is contained in the prefix. False if either is zero.
+func (self NullAddr) In(val NullPrefix) bool { return val.Contains(self) }
+
+// Returns the IPv6 zone, if any.
+func (self NullAddr) Zone() string { return self.Addr().Zone() }
+
+/*
+Returns a copy with the given IPv6 zone. An empty zone removes the zone. Has no
+effect on zero or IPv4 addresses.
+*/
+func (self NullAddr) WithZone(zone string) NullAddr {
+	return NullAddr(self.Addr().WithZone(zone))
+}
@@ -0,0 +1,183 @@
+package gt_test
+
+import (
+	"net"
+	"net/netip"
+	"testing"
+
+	"github.com/mitranim/gt"
+)
+
+func TestNullAddr_common(t *testing.T) {
+	var (
+		primZero    = any(nil)
+		primNonZero = `2001:db8::1`
+		textZero    = ``
+		textNonZero = `2001:db8::1`
+		jsonZero    = bytesNull
+		jsonNonZero = []byte(`"2001:db8::1"`)
+		zero        = gt.NullAddr{}
+		nonZero     = gt.NullAddrFrom(netip.MustParseAddr(`2001:db8::1`))
+		dec         = new(gt.NullAddr)
+	)
+
+	eq(true, zero.IsNull())
+	eq(false, nonZero.IsNull())
+
+	testAny(t, primZero, primNonZero, textZero, textNonZero, jsonZero, jsonNonZero, zero, nonZero, dec)
+}
+
+func TestNullPrefix_common(t *testing.T) {
+	var (
+		primZero    = any(nil)
+		primNonZero = `192.168.0.1/24`
+		textZero    = ``
+		textNonZero = `192.168.0.1/24`
+		jsonZero    = bytesNull
+		jsonNonZero = []byte(`"192.168.0.1/24"`)
+		zero        = gt.NullPrefix{}
+		nonZero     = gt.NullPrefixFrom(netip.MustParsePrefix(`192.168.0.1/24`))
+		dec         = new(gt.NullPrefix)
+	)
+
+	eq(true, zero.IsNull())
+	eq(false, nonZero.IsNull())
+
+	testAny(t, primZero, primNonZero, textZero, textNonZero, jsonZero, jsonNonZero, zero, nonZero, dec)
+}
+
+func TestNullAddr(t *testing.T) {
+	t.Run(`Parse`, func(t *testing.T) {
+		test := func(exp, src string) {
+			t.Helper()
+			eq(exp, gt.ParseNullAddr(src).String())
+		}
+
+		test(``, ``)
+		test(`192.168.0.1`, `192.168.0.1`)
+		test(`192.168.0.1`, `192.168.0.1/24`)
+		test(`192.168.0.1`, `192.168.0.1/32`)
+		test(`::ffff:192.168.0.1`, `::ffff:192.168.0.1`)
+		test(`2001:db8::1`, `2001:0db8:0000::0001`)
+		test(`2001:db8::1`, `2001:db8::1/64`)
+		test(`fe80::1%eth0`, `fe80::1%eth0`)
+
+		var val gt.NullAddr
+		fail(val.Parse(`192.168.0`))
+		fail(val.Parse(`192.168.0.256`))
+		fail(val.Parse(`192.168.0.1/33`))
+		fail(val.Parse(`localhost`))
+		eq(gt.NullAddr{}, val)
+	})
+
+	t.Run(`zone`, func(t *testing.T) {
+		val := gt.ParseNullAddr(`fe80::1%eth0`)
+		eq(`eth0`, val.Zone())
+		eq(`fe80::1`, tryInterface(val.Value()))
+		eq(`fe80::1`, val.WithZone(``).String())
+		eq(`"fe80::1%eth0"`, string(jsonBytes(val)))
+		eq(`fe80::1/128`, val.NullPrefix().String())
+	})
+
+	t.Run(`Scan`, func(t *testing.T) {
+		test := func(exp string, src any) {
+			t.Helper()
+			var val gt.NullAddr
+			try(val.Scan(src))
+			eq(exp, val.String())
+		}
+
+		test(``, nil)
+		test(`10.0.0.1`, `10.0.0.1`)
+		test(`10.0.0.1`, []byte(`10.0.0.1/8`))
+		test(`10.0.0.1`, netip.MustParseAddr(`10.0.0.1`))
+		test(`10.0.0.1`, net.ParseIP(`10.0.0.1`))
+		test(`2001:db8::1`, net.ParseIP(`2001:db8::1`))
+		test(``, net.IP(nil))
+		test(`10.0.0.1`, gt.ParseNullPrefix(`10.0.0.1/8`))
+		test(`10.0.0.1`, gt.NullString(`10.0.0.1`))
+
+		var val gt.NullAddr
+		fail(val.Scan(net.IP{1, 2, 3}))
+		fail(val.Scan(int64(1)))
+	})
+}
+
+func TestNullPrefix(t *testing.T) {
+	t.Run(`Parse`, func(t *testing.T) {
+		test := func(exp, src string) {
+			t.Helper()
+			eq(exp, gt.ParseNullPrefix(src).String())
+		}
+
+		test(``, ``)
+		test(`10.0.0.0/8`, `10.0.0.0/8`)
+		test(`10.1.2.3/8`, `10.1.2.3/8`)
+		test(`10.1.2.3/32`, `10.1.2.3`)
+		test(`2001:db8::/32`, `2001:db8::/32`)
+		test(`2001:db8::1/128`, `2001:db8::1`)
+
+		var val gt.NullPrefix
+		fail(val.Parse(`10.0.0.0/33`))
+		fail(val.Parse(`10.0.0.0/`))
+		fail(val.Parse(`fe80::1%eth0`))
+		fail(val.Parse(`fe80::1%eth0/64`))
+		fail(val.Parse(`10.0.0`))
+		eq(gt.NullPrefix{}, val)
+	})
+
+	t.Run(`methods`, func(t *testing.T) {
+		pre := gt.ParseNullPrefix(`192.168.1.77/24`)
+
+		eq(`192.168.1.77`, pre.Addr().String())
+		eq(24, pre.Bits())
+		eq(-1, gt.NullPrefix{}.Bits())
+		eq(`192.168.1.0/24`, pre.Masked().String())
+		eq(gt.NullPrefix{}, gt.NullPrefix{}.Masked())
+		eq(false, pre.IsSingleIP())
+		eq(true, gt.ParseNullPrefix(`192.168.1.77`).IsSingleIP())
+
+		eq(true, pre.Contains(gt.ParseNullAddr(`192.168.1.1`)))
+		eq(true, pre.Contains(gt.ParseNullAddr(`192.168.1.255`)))
+		eq(false, pre.Contains(gt.ParseNullAddr(`192.168.2.1`)))
+		eq(false, pre.Contains(gt.ParseNullAddr(`::1`)))
+		eq(false, pre.Contains(gt.NullAddr{}))
+		eq(false, gt.NullPrefix{}.Contains(gt.ParseNullAddr(`192.168.1.1`)))
+		eq(true, gt.ParseNullAddr(`192.168.1.1`).In(pre))
+		eq(true, gt.ParseNullPrefix(`fe80::/64`).Contains(gt.ParseNullAddr(`fe80::1%eth0`)))
+
+		eq(true, gt.ParseNullPrefix(`192.168.0.0/16`).ContainsPrefix(pre))
+		eq(true, pre.ContainsPrefix(pre))
+		eq(false, pre.ContainsPrefix(gt.ParseNullPrefix(`192.168.0.0/16`)))
+		eq(false, pre.ContainsPrefix(gt.NullPrefix{}))
+
+		eq(true, pre.Overlaps(gt.ParseNullPrefix(`192.168.0.0/16`)))
+		eq(false, pre.Overlaps(gt.ParseNullPrefix(`10.0.0.0/8`)))
+	})
+
+	t.Run(`Scan`, func(t *testing.T) {
+		test := func(exp string, src any) {
+			t.Helper()
+			var val gt.NullPrefix
+			try(val.Scan(src))
+			eq(exp, val.String())
+		}
+
+		_, ipNet, err := net.ParseCIDR(`10.0.0.0/8`)
+		try(err)
+
+		test(``, nil)
+		test(`10.0.0.0/8`, `10.0.0.0/8`)
+		test(`10.0.0.1/32`, []byte(`10.0.0.1`))
+		test(`10.0.0.0/8`, netip.MustParsePrefix(`10.0.0.0/8`))
+		test(`10.0.0.0/8`, ipNet)
+		test(``, (*net.IPNet)(nil))
+		test(`10.0.0.1/32`, netip.MustParseAddr(`10.0.0.1`))
+		test(`10.0.0.1/32`, gt.ParseNullAddr(`10.0.0.1`))
+		test(``, gt.NullAddr{})
+
+		var val gt.NullPrefix
+		fail(val.Scan(gt.ParseNullAddr(`fe80::1%eth0`)))
+		fail(val.Scan(true))
+	})
+}
@@ -0,0 +1,297 @@
+package gt
+
+import (
+	"database/sql/driver"
+	"encoding/base64"
+	"encoding/hex"
+	"strings"
+)
+
+/*
+Variant of `[]byte` for binary data, such as Postgres `bytea`, where zero value
+is considered empty in text, and null in JSON and SQL. In SQL, stores and
+returns raw bytes as-is. In text and JSON, uses standard base64 with padding
+(RFC 4648 section 4), the same as `json.Marshal` for `[]byte`. Decoding also
+accepts base64 without padding.
+
+Like `gt.Raw`, decoding reuses the receiver's capacity: if the receiver had
+enough capacity, its backing array may be mutated. The length is what matters:
+an empty non-nil slice is considered zero.
+
+For URL-safe base64, see `gt.NullBytesUrl`. For hex, see `gt.NullHex`.
+*/
+type NullBytes []byte
+
+var (
+	_ = Encodable(NullBytes(nil))
+	_ = Decodable((*NullBytes)(nil))
+)
+
+// Implement `gt.Zeroable`. Equivalent to `len(self)` <= 0.
+// NOT equivalent to `self == nil` or `reflect.ValueOf(self).IsZero()`.
+func (self NullBytes) IsZero() bool { return len(self) <= 0 }
+
+// Implement `gt.Nullable`. True if empty.
+func (self NullBytes) IsNull() bool { return self.IsZero() }
+
+/*
+Implement `gt.Getter`. If empty, returns `nil`, otherwise returns self as
+`[]byte`.
+*/
+func (self NullBytes) Get() any {
+	if self.IsNull() {
+		return nil
+	}
+	return []byte(self)
+}
+
+// Implement `gt.Setter`, using `.Scan`. Panics on error.
+func (self *NullBytes) Set(src any) { try(self.Scan(src)) }
+
+/*
+Implement `gt.Zeroer`, emptying the receiver. If the receiver was non-nil, its
+length is reduced to 0 while keeping any capacity, and it remains non-nil.
+*/
+func (self *NullBytes) Zero() { bytesZero((*[]byte)(self)) }
+
+// Implement `fmt.Stringer`, returning standard base64 with padding.
+func (self NullBytes) String() string { return bytesString(self.AppendTo(nil)) }
+
+/*
+Implement `gt.Parser`. If the input is empty, empties the receiver while keeping
+any capacity. Otherwise decodes base64 with or without padding, reusing the
+receiver's capacity.
+*/
+func (self *NullBytes) Parse(src string) error {
+	return self.UnmarshalText([]byte(src))
+}
+
+// Implement `gt.AppenderTo`, appending standard base64 with padding.
+func (self NullBytes) AppendTo(buf []byte) []byte {
+	return bytesEncode(buf, bytesBase64Std, self)
+}
+
+/*
+Implement `encoding.TextMarhaler`. If empty, returns nil. Otherwise returns the
+same representation as `.String`.
+*/
+func (self NullBytes) MarshalText() ([]byte, error) {
+	if self.IsNull() {
+		return nil, nil
+	}
+	return self.AppendTo(nil), nil
+}
+
+// Implement `encoding.TextUnmarshaler`, using the same algorithm as `.Parse`.
+func (self *NullBytes) UnmarshalText(src []byte) error {
+	return bytesDecode((*[]byte)(self), bytesBase64Std, src, `base64`)
+}
+
+/*
+Implement `json.Marshaler`. If empty, returns bytes representing `null`.
+Otherwise returns bytes representing a JSON string with the same text as in
+`.String`.
+*/
+func (self NullBytes) MarshalJSON() ([]byte, error) {
+	return bytesMarshalJson(bytesBase64Std, self)
+}
+
+/*
+Implement `json.Unmarshaler`. If the input is empty or represents JSON `null`,
+empties the receiver while keeping any capacity. Otherwise parses a JSON
+string, using the same algorithm as `.Parse`.
+*/
+func (self *NullBytes) UnmarshalJSON(src []byte) error {
+	if isJsonEmpty(src) {
+		self.Zero()
+		return nil
+	}
+	if isJsonStr(src) {
+		return self.UnmarshalText(cutJsonStr(src))
+	}
+	return errJsonString(src, self)
+}
+
+// Implement `driver.Valuer`, using `.Get`.
+func (self NullBytes) Value() (driver.Value, error) { return self.Get(), nil }
+
+/*
+Implement `sql.Scanner`, converting an arbitrary input to `gt.NullBytes` and
+modifying the receiver. Unlike most other decoding methods, `[]byte` is treated
+as raw binary data rather than text. Acceptable inputs:
+
+  - `nil`             -> use `.Zero`
+  - `[]byte`          -> copy as-is
+  - `string`          -> decode `bytea` hex format "\x..." or use `.Parse`
+  - `gt.NullBytes`    -> assign
+  - `gt.NullBytesUrl` -> copy as-is
+  - `gt.NullHex`      -> copy as-is
+  - `gt.Raw`          -> copy as-is
+  - `gt.Getter`       -> scan underlying value
+*/
+func (self *NullBytes) Scan(src any) error {
+	switch src := src.(type) {
+	case string:
+		ok, err := bytesScanBytea((*[]byte)(self), src)
+		if ok {
+			return err
+		}
+		return self.Parse(src)
+
+	case NullBytes:
+		*self = src
+		return nil
+
+	default:
+		ok, err := bytesScan((*[]byte)(self), src)
+		if ok {
+			return err
+		}
+		val, ok := get(src)
+		if ok {
+			return self.Scan(val)
+		}
+		return errScanType(self, src)
+	}
+}
+
+// Same as `len(self)`.
+func (self NullBytes) Len() int { return len(self) }
+
+// Subset of `*base64.Encoding` methods, used by binary types to share code.
+type bytesCodec interface {
+	EncodedLen(int) int
+	Encode([]byte, []byte)
+	DecodedLen(int) int
+	Decode([]byte, []byte) (int, error)
+}
+
+/*
+Encodes with the given encoding. Decoding uses the padded or unpadded encoding
+depending on the input length.
+*/
+type bytesBase64 struct{ enc, padded, raw *base64.Encoding }
+
+var (
+	bytesBase64Std = bytesBase64{base64.StdEncoding, base64.StdEncoding, base64.RawStdEncoding}
+	bytesBase64Url = bytesBase64{base64.RawURLEncoding, base64.URLEncoding, base64.RawURLEncoding}
+)
+
+func (self bytesBase64) EncodedLen(val int) int { return self.enc.EncodedLen(val) }
+func (self bytesBase64) Encode(tar, src []byte) { self.enc.Encode(tar, src) }
+func (self bytesBase64) DecodedLen(val int) int { return self.pick(val).DecodedLen(val) }
+
+func (self bytesBase64) Decode(tar, src []byte) (int, error) {
+	return self.pick(len(src)).Decode(tar, src)
+}
+
+func (self bytesBase64) pick(size int) *base64.Encoding {
+	if size%4 == 0 {
+		return self.padded
+	}
+	return self.raw
+}
+
+type bytesHex struct{}
+
+func (bytesHex) EncodedLen(val int) int { return hex.EncodedLen(val) }
+func (bytesHex) Encode(tar, src []byte) { hex.Encode(tar, src) }
+func (bytesHex) DecodedLen(val int) int { return hex.DecodedLen(val) }
+func (bytesHex) Decode(tar, src []byte) (int, error) {
+	return hex.Decode(tar, src)
+}
+
+func bytesZero(tar *[]byte) {
+	if tar != nil && *tar != nil {
+		*tar = (*tar)[:0]
+	}
+}
+
+func bytesEncode(buf []byte, codec bytesCodec, src []byte) []byte {
+	size := len(buf)
+	buf = Raw(buf).Grow(codec.EncodedLen(len(src)))
+	buf = buf[:size+codec.EncodedLen(len(src))]
+	codec.Encode(buf[size:], src)
+	return buf
+}
+
+func bytesMarshalJson(codec bytesCodec, src []byte) ([]byte, error) {
+	if len(src) <= 0 {
+		return bytesNull, nil
+	}
+
+	buf := make([]byte, 0, codec.EncodedLen(len(src))+2)
+	buf = append(buf, '"')
+	buf = bytesEncode(buf, codec, src)
+	buf = append(buf, '"')
+	return buf, nil
+}
+
+/*
+Decodes into the target, reusing its capacity. Decodes into the spare capacity
+past the current length, and moves the result into place only on success. On
+error, the target is unchanged.
+*/
+func bytesDecode(tar *[]byte, codec bytesCodec, src []byte, typ string) (err error) {
+	if len(src) <= 0 {
+		bytesZero(tar)
+		return nil
+	}
+
+	defer errParse(&err, bytesString(src), typ)
+
+	prev := len(*tar)
+	maxLen := codec.DecodedLen(len(src))
+	buf := Raw(*tar).Grow(maxLen)
+
+	size, err := codec.Decode(buf[prev:prev+maxLen], src)
+	if err != nil {
+		return err
+	}
+
+	*tar = buf[:copy(buf[:size], buf[prev:prev+size])]
+	return nil
+}
+
+/*
+Decodes the Postgres `bytea` hex output format, such as "\x0102". Returns false
+if the input doesn't have the "\x" prefix.
+*/
+func bytesScanBytea(tar *[]byte, src string) (bool, error) {
+	if !strings.HasPrefix(src, `\x`) {
+		return false, nil
+	}
+
+	return true, bytesDecode(tar, bytesHex{}, []byte(src[2:]), `bytea`)
+}
+
+/*
+Handles inputs shared by all binary types, which are copied as-is, reusing the
+target's capacity. Returns false for unsupported inputs.
+*/
+func bytesScan(tar *[]byte, src any) (bool, error) {
+	var val []byte
+
+	switch src := src.(type) {
+	case nil:
+	case []byte:
+		val = src
+	case NullBytes:
+		val = src
+	case NullBytesUrl:
+		val = src
+	case NullHex:
+		val = src
+	case Raw:
+		val = src
+	default:
+		return false, nil
+	}
+
+	if len(val) <= 0 {
+		bytesZero(tar)
+	} else {
+		*tar = append((*tar)[:0], val...)
+	}
+	return true, nil
+}
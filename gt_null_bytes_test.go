@@ -0,0 +1,206 @@
+package gt_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/mitranim/gt"
+)
+
+var testBytes = []byte{0xfb, 0xff, 0x00, 0x01, 0x7e}
+
+func TestNullBytes(t *testing.T) {
+	t.Run(`encoding`, func(t *testing.T) {
+		testBytesEncoding(t, new(gt.NullBytes), gt.NullBytes(testBytes), `+/8AAX4=`)
+	})
+
+	t.Run(`Parse`, func(t *testing.T) {
+		test := func(exp []byte, src string) {
+			t.Helper()
+			var val gt.NullBytes
+			try(val.Parse(src))
+			eq(exp, []byte(val))
+		}
+
+		test(nil, ``)
+		test(testBytes, `+/8AAX4=`)
+		test(testBytes, `+/8AAX4`)
+		test([]byte(`hello`), `aGVsbG8=`)
+
+		var val gt.NullBytes
+		fail(val.Parse(`-_8AAX4`))
+		fail(val.Parse(`+/8AAX4==`))
+		fail(val.Parse(`a`))
+		fail(val.Parse(`!!!!`))
+		eq(gt.NullBytes(nil), val)
+
+		val = gt.NullBytes{1, 2, 3}
+		fail(val.Parse(`////!`))
+		eq(gt.NullBytes{1, 2, 3}, val)
+	})
+
+	t.Run(`matches json.Marshal of []byte`, func(t *testing.T) {
+		eq(jsonBytes(testBytes), jsonBytes(gt.NullBytes(testBytes)))
+	})
+
+	t.Run(`capacity`, func(t *testing.T) {
+		buf := make(gt.NullBytes, 0, 16)
+		prev := buf
+
+		try(buf.Parse(`aGVsbG8=`))
+		eq(gt.NullBytes(`hello`), buf)
+		sliceShared(prev, buf)
+
+		try(buf.UnmarshalJSON([]byte(`"d29ybGQ="`)))
+		eq(gt.NullBytes(`world`), buf)
+		sliceShared(prev, buf)
+
+		try(buf.Scan([]byte(`raw`)))
+		eq(gt.NullBytes(`raw`), buf)
+		sliceShared(prev, buf)
+
+		try(buf.Scan(nil))
+		eq(0, len(buf))
+		eq(16, cap(buf))
+	})
+}
+
+func TestNullBytesUrl(t *testing.T) {
+	t.Run(`encoding`, func(t *testing.T) {
+		testBytesEncoding(t, new(gt.NullBytesUrl), gt.NullBytesUrl(testBytes), `-_8AAX4`)
+	})
+
+	t.Run(`Parse`, func(t *testing.T) {
+		test := func(exp []byte, src string) {
+			t.Helper()
+			var val gt.NullBytesUrl
+			try(val.Parse(src))
+			eq(exp, []byte(val))
+		}
+
+		test(nil, ``)
+		test(testBytes, `-_8AAX4`)
+		test(testBytes, `-_8AAX4=`)
+
+		var val gt.NullBytesUrl
+		fail(val.Parse(`+/8AAX4`))
+		fail(val.Parse(`-_8AA`))
+		eq(gt.NullBytesUrl(nil), val)
+	})
+}
+
+func TestNullHex(t *testing.T) {
+	t.Run(`encoding`, func(t *testing.T) {
+		testBytesEncoding(t, new(gt.NullHex), gt.NullHex(testBytes), `fbff00017e`)
+	})
+
+	t.Run(`Parse`, func(t *testing.T) {
+		test := func(exp []byte, src string) {
+			t.Helper()
+			var val gt.NullHex
+			try(val.Parse(src))
+			eq(exp, []byte(val))
+		}
+
+		test(nil, ``)
+		test(testBytes, `fbff00017e`)
+		test(testBytes, `FBFF00017E`)
+		test([]byte{0, 0}, `0000`)
+
+		var val gt.NullHex
+		fail(val.Parse(`fbff00017`))
+		fail(val.Parse(`fbff00017g`))
+		fail(val.Parse(`\xfbff00017e`))
+		eq(gt.NullHex(nil), val)
+
+		val = gt.NullHex{0x0a, 0x1b}
+		fail(val.Parse(`abz`))
+		eq(gt.NullHex{0x0a, 0x1b}, val)
+
+		val = make(gt.NullHex, 2, 16)
+		copy(val, []byte{0x0a, 0x1b})
+		fail(val.Parse(`abcdz`))
+		eq(gt.NullHex{0x0a, 0x1b}, val)
+	})
+}
+
+/*
+Shared by binary types. They can't use `testAny` because `.Scan` treats `[]byte`
+as raw data rather than text.
+*/
+func testBytesEncoding[
+	Tar interface {
+		~[]byte
+		gt.Encodable
+	},
+	Ptr interface {
+		*Tar
+		gt.Decodable
+	},
+](t *testing.T, dec Ptr, val Tar, text string) {
+	t.Helper()
+
+	var zero Tar
+	eq(true, zero.IsNull())
+	eq(true, Tar{}.IsNull())
+	eq(false, val.IsNull())
+
+	eq(``, zero.String())
+	eq(text, val.String())
+
+	eq([]byte(nil), tryByteSlice(zero.MarshalText()))
+	eq([]byte(text), tryByteSlice(val.MarshalText()))
+
+	eq(bytesNull, jsonBytes(zero))
+	eq(bytesNull, jsonBytes(Tar{}))
+	eq([]byte(`"`+text+`"`), jsonBytes(val))
+
+	eq(nil, tryInterface(zero.Value()))
+	eq([]byte(val), tryInterface(val.Value()))
+
+	try(dec.Parse(text))
+	eq(val, *dec)
+	try(dec.Parse(``))
+	eq(true, (*dec).IsNull())
+
+	try(dec.UnmarshalText([]byte(text)))
+	eq(val, *dec)
+	try(dec.UnmarshalText(nil))
+	eq(true, (*dec).IsNull())
+
+	try(json.Unmarshal([]byte(`"`+text+`"`), dec))
+	eq(val, *dec)
+	try(json.Unmarshal(bytesNull, dec))
+	eq(true, (*dec).IsNull())
+	fail(json.Unmarshal([]byte(`123`), dec))
+
+	// Raw bytes in SQL.
+	try(dec.Scan([]byte(val)))
+	eq(val, *dec)
+	try(dec.Scan([]byte(nil)))
+	eq(true, (*dec).IsNull())
+
+	// Text encoding when scanning strings.
+	try(dec.Scan(text))
+	eq(val, *dec)
+	try(dec.Scan(``))
+	eq(true, (*dec).IsNull())
+
+	// Postgres `bytea` hex output.
+	try(dec.Scan(`\xfbff00017e`))
+	eq(val, *dec)
+	try(dec.Scan(`\x`))
+	eq(true, (*dec).IsNull())
+	fail(dec.Scan(`\xfbff00017`))
+
+	try(dec.Scan(gt.Raw(val)))
+	eq(val, *dec)
+	try(dec.Scan(gt.NullBytes(val)))
+	eq(val, *dec)
+	try(dec.Scan(gt.NullBytesUrl(val)))
+	eq(val, *dec)
+	try(dec.Scan(gt.NullHex(val)))
+	eq(val, *dec)
+
+	fail(dec.Scan(int64(1)))
+}
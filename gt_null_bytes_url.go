@@ -0,0 +1,147 @@
+package gt
+
+import "database/sql/driver"
+
+/*
+Variant of `gt.NullBytes` which uses URL-safe base64 (RFC 4648 section 5) in
+text and JSON, without padding, as commonly used in URLs and tokens. Decoding
+also accepts URL-safe base64 with padding. In SQL, stores and returns raw bytes
+as-is. Follows the same capacity-reusing semantics as `gt.NullBytes`.
+*/
+type NullBytesUrl []byte
+
+var (
+	_ = Encodable(NullBytesUrl(nil))
+	_ = Decodable((*NullBytesUrl)(nil))
+)
+
+// Implement `gt.Zeroable`. Equivalent to `len(self)` <= 0.
+// NOT equivalent to `self == nil` or `reflect.ValueOf(self).IsZero()`.
+func (self NullBytesUrl) IsZero() bool { return len(self) <= 0 }
+
+// Implement `gt.Nullable`. True if empty.
+func (self NullBytesUrl) IsNull() bool { return self.IsZero() }
+
+/*
+Implement `gt.Getter`. If empty, returns `nil`, otherwise returns self as
+`[]byte`.
+*/
+func (self NullBytesUrl) Get() any {
+	if self.IsNull() {
+		return nil
+	}
+	return []byte(self)
+}
+
+// Implement `gt.Setter`, using `.Scan`. Panics on error.
+func (self *NullBytesUrl) Set(src any) { try(self.Scan(src)) }
+
+/*
+Implement `gt.Zeroer`, emptying the receiver. If the receiver was non-nil, its
+length is reduced to 0 while keeping any capacity, and it remains non-nil.
+*/
+func (self *NullBytesUrl) Zero() { bytesZero((*[]byte)(self)) }
+
+// Implement `fmt.Stringer`, returning URL-safe base64 without padding.
+func (self NullBytesUrl) String() string { return bytesString(self.AppendTo(nil)) }
+
+/*
+Implement `gt.Parser`. If the input is empty, empties the receiver while keeping
+any capacity. Otherwise decodes URL-safe base64 with or without padding,
+reusing the receiver's capacity.
+*/
+func (self *NullBytesUrl) Parse(src string) error {
+	return self.UnmarshalText([]byte(src))
+}
+
+// Implement `gt.AppenderTo`, appending URL-safe base64 without padding.
+func (self NullBytesUrl) AppendTo(buf []byte) []byte {
+	return bytesEncode(buf, bytesBase64Url, self)
+}
+
+/*
+Implement `encoding.TextMarhaler`. If empty, returns nil. Otherwise returns the
+same representation as `.String`.
+*/
+func (self NullBytesUrl) MarshalText() ([]byte, error) {
+	if self.IsNull() {
+		return nil, nil
+	}
+	return self.AppendTo(nil), nil
+}
+
+// Implement `encoding.TextUnmarshaler`, using the same algorithm as `.Parse`.
+func (self *NullBytesUrl) UnmarshalText(src []byte) error {
+	return bytesDecode((*[]byte)(self), bytesBase64Url, src, `base64url`)
+}
+
+/*
+Implement `json.Marshaler`. If empty, returns bytes representing `null`.
+Otherwise returns bytes representing a JSON string with the same text as in
+`.String`.
+*/
+func (self NullBytesUrl) MarshalJSON() ([]byte, error) {
+	return bytesMarshalJson(bytesBase64Url, self)
+}
+
+/*
+Implement `json.Unmarshaler`. If the input is empty or represents JSON `null`,
+empties the receiver while keeping any capacity. Otherwise parses a JSON
+string, using the same algorithm as `.Parse`.
+*/
+func (self *NullBytesUrl) UnmarshalJSON(src []byte) error {
+	if isJsonEmpty(src) {
+		self.Zero()
+		return nil
+	}
+	if isJsonStr(src) {
+		return self.UnmarshalText(cutJsonStr(src))
+	}
+	return errJsonString(src, self)
+}
+
+// Implement `driver.Valuer`, using `.Get`.
+func (self NullBytesUrl) Value() (driver.Value, error) { return self.Get(), nil }
+
+/*
+Implement `sql.Scanner`, converting an arbitrary input to `gt.NullBytesUrl` and
+modifying the receiver. Unlike most other decoding methods, `[]byte` is treated
+as raw binary data rather than text. Acceptable inputs:
+
+  - `nil`             -> use `.Zero`
+  - `[]byte`          -> copy as-is
+  - `string`          -> decode `bytea` hex format "\x..." or use `.Parse`
+  - `gt.NullBytesUrl` -> assign
+  - `gt.NullBytes`    -> copy as-is
+  - `gt.NullHex`      -> copy as-is
+  - `gt.Raw`          -> copy as-is
+  - `gt.Getter`       -> scan underlying value
+*/
+func (self *NullBytesUrl) Scan(src any) error {
+	switch src := src.(type) {
+	case string:
+		ok, err := bytesScanBytea((*[]byte)(self), src)
+		if ok {
+			return err
+		}
+		return self.Parse(src)
+
+	case NullBytesUrl:
+		*self = src
+		return nil
+
+	default:
+		ok, err := bytesScan((*[]byte)(self), src)
+		if ok {
+			return err
+		}
+		val, ok := get(src)
+		if ok {
+			return self.Scan(val)
+		}
+		return errScanType(self, src)
+	}
+}
+
+// Same as `len(self)`.
+func (self NullBytesUrl) Len() int { return len(self) }
@@ -0,0 +1,223 @@
+package gt
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+/*
+Case-insensitive variant of `gt.NullString`, suitable for usernames, tags, and
+Postgres `citext`. Stores and encodes the original string as-is, preserving its
+case, but comparison via `.Equal` and `.Compare` ignores case, using Unicode
+simple case folding, like `strings.EqualFold`. For map keys and lookups, use
+`.Fold`. Note that `==` is still case-sensitive.
+
+Just like `gt.NullString`, zero value is considered empty in text, and null in
+JSON and SQL.
+*/
+type NullCiString string
+
+var (
+	_ = Encodable(NullCiString(``))
+	_ = Decodable((*NullCiString)(nil))
+)
+
+// Implement `gt.Zeroable`. True if empty.
+func (self NullCiString) IsZero() bool { return self == `` }
+
+// Implement `gt.Nullable`. True if empty.
+func (self NullCiString) IsNull() bool { return self.IsZero() }
+
+// Implement `gt.PtrGetter`, returning `*string`.
+func (self *NullCiString) GetPtr() any { return (*string)(self) }
+
+// Implement `gt.Getter`. If zero, returns `nil`, otherwise returns `string`.
+func (self NullCiString) Get() any {
+	if self.IsNull() {
+		return nil
+	}
+	return string(self)
+}
+
+// Implement `gt.Setter`, using `.Scan`. Panics on error.
+func (self *NullCiString) Set(src any) { try(self.Scan(src)) }
+
+// Implement `gt.Zeroer`, zeroing the receiver.
+func (self *NullCiString) Zero() {
+	if self != nil {
+		*self = ``
+	}
+}
+
+// Implement `fmt.Stringer`, returning the string as-is.
+func (self NullCiString) String() string { return string(self) }
+
+// Implement `gt.Parser`, assigning the string as-is.
+func (self *NullCiString) Parse(src string) error {
+	*self = NullCiString(src)
+	return nil
+}
+
+// Implement `gt.AppenderTo`, appending the string as-is.
+func (self NullCiString) AppendTo(buf []byte) []byte { return append(buf, self...) }
+
+/*
+Implement `encoding.TextMarhaler`. If zero, returns nil. Otherwise returns the
+string as-is.
+*/
+func (self NullCiString) MarshalText() ([]byte, error) {
+	if self.IsNull() {
+		return nil, nil
+	}
+	return self.AppendTo(nil), nil
+}
+
+// Implement `encoding.TextUnmarshaler`, assigning a copy of the string as-is.
+func (self *NullCiString) UnmarshalText(src []byte) error {
+	*self = NullCiString(src)
+	return nil
+}
+
+/*
+Implement `json.Marshaler`. If zero, returns bytes representing `null`.
+Otherwise uses the default `json.Marshal` behavior for `string`.
+*/
+func (self NullCiString) MarshalJSON() ([]byte, error) {
+	if self.IsNull() {
+		return bytesNull, nil
+	}
+	return json.Marshal(string(self))
+}
+
+/*
+Implement `json.Unmarshaler`. If the input is empty or represents JSON `null`,
+zeroes the receiver. Otherwise uses the default `json.Unmarshal` behavior
+for `*string`.
+*/
+func (self *NullCiString) UnmarshalJSON(src []byte) error {
+	if isJsonEmpty(src) {
+		self.Zero()
+		return nil
+	}
+	return json.Unmarshal(src, self.GetPtr())
+}
+
+// Implement `driver.Valuer`, using `.Get`.
+func (self NullCiString) Value() (driver.Value, error) { return self.Get(), nil }
+
+/*
+Implement `sql.Scanner`, converting an arbitrary input to `gt.NullCiString` and
+modifying the receiver. Postgres `citext` is scanned like any other text.
+Acceptable inputs:
+
+  - `nil`             -> use `.Zero`
+  - `string`          -> use `.Parse`
+  - `[]byte`          -> use `.UnmarshalText`
+  - `gt.NullCiString` -> assign
+  - `gt.NullString`   -> use `.Parse`
+  - `gt.Getter`       -> scan underlying value
+*/
+func (self *NullCiString) Scan(src any) error {
+	switch src := src.(type) {
+	case nil:
+		self.Zero()
+		return nil
+
+	case string:
+		return self.Parse(src)
+
+	case []byte:
+		return self.UnmarshalText(src)
+
+	case NullCiString:
+		*self = src
+		return nil
+
+	case NullString:
+		return self.Parse(string(src))
+
+	default:
+		val, ok := get(src)
+		if ok {
+			return self.Scan(val)
+		}
+		return errScanType(self, src)
+	}
+}
+
+// Same as `len(self)`.
+func (self NullCiString) Len() int { return len(self) }
+
+// True if the strings are equal under Unicode simple case folding.
+func (self NullCiString) Equal(val NullCiString) bool {
+	return strings.EqualFold(string(self), string(val))
+}
+
+/*
+Compares case-insensitively, returning -1, 0, or 1, consistently with `.Equal`
+and `.Fold`. Suitable for sorting.
+*/
+func (self NullCiString) Compare(val NullCiString) int {
+	return strings.Compare(self.Fold(), val.Fold())
+}
+
+/*
+Returns the case-folded form of the string, suitable as a map key or a lookup
+key. Two strings have the same folded form if and only if `.Equal` is true.
+Each character is replaced with the lowercase member of its case folding
+class, which means the result is usually lowercase. Doesn't allocate if the
+string is already folded ASCII.
+*/
+func (self NullCiString) Fold() string { return ciFold(string(self)) }
+
+func ciFold(src string) string {
+	ind := 0
+	for ; ind < len(src); ind++ {
+		char := src[ind]
+		if char >= utf8.RuneSelf || (char >= 'A' && char <= 'Z') {
+			break
+		}
+	}
+	if ind >= len(src) {
+		return src
+	}
+
+	var buf strings.Builder
+	buf.Grow(len(src))
+	buf.WriteString(src[:ind])
+	for _, char := range src[ind:] {
+		buf.WriteRune(ciFoldRune(char))
+	}
+	return buf.String()
+}
+
+/*
+Returns a canonical member of the rune's case folding orbit, as used by
+`unicode.SimpleFold`: the lowercase form of the smallest member, if it belongs
+to the same orbit, otherwise the smallest member itself. Staying within the
+orbit keeps distinct orbits distinct. For example, "İ" (U+0130) lowercases to
+"i", which belongs to a different orbit, so "İ" is kept as-is, just like in
+`strings.EqualFold`.
+*/
+func ciFoldRune(char rune) rune {
+	least := char
+	for next := unicode.SimpleFold(char); next != char; next = unicode.SimpleFold(next) {
+		if next < least {
+			least = next
+		}
+	}
+
+	lower := unicode.ToLower(least)
+	for next := least; ; {
+		if next == lower {
+			return lower
+		}
+		next = unicode.SimpleFold(next)
+		if next == least {
+			return least
+		}
+	}
+}
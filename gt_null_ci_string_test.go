@@ -0,0 +1,94 @@
+package gt_test
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/mitranim/gt"
+)
+
+func TestNullCiString_common(t *testing.T) {
+	var (
+		primZero    = any(nil)
+		primNonZero = `Hello World`
+		textZero    = ``
+		textNonZero = `Hello World`
+		jsonZero    = bytesNull
+		jsonNonZero = []byte(`"Hello World"`)
+		zero        = gt.NullCiString(``)
+		nonZero     = gt.NullCiString(`Hello World`)
+		dec         = new(gt.NullCiString)
+	)
+
+	eq(true, zero.IsNull())
+	eq(false, nonZero.IsNull())
+
+	testAny(t, primZero, primNonZero, textZero, textNonZero, jsonZero, jsonNonZero, zero, nonZero, dec)
+}
+
+func TestNullCiString(t *testing.T) {
+	t.Run(`preserves original`, func(t *testing.T) {
+		var val gt.NullCiString
+
+		try(val.Parse(` MiXeD `))
+		eq(gt.NullCiString(` MiXeD `), val)
+
+		try(val.Scan([]byte(`CiText`)))
+		eq(gt.NullCiString(`CiText`), val)
+
+		try(val.Scan(gt.NullString(`Other`)))
+		eq(gt.NullCiString(`Other`), val)
+
+		try(val.UnmarshalJSON([]byte(`"Quote\"d"`)))
+		eq(gt.NullCiString(`Quote"d`), val)
+		eq([]byte(`"Quote\"d"`), jsonBytes(val))
+
+		fail(val.Scan(10))
+	})
+
+	t.Run(`Equal`, func(t *testing.T) {
+		test := func(exp bool, one, two gt.NullCiString) {
+			t.Helper()
+			eq(exp, one.Equal(two))
+			eq(exp, two.Equal(one))
+			eq(exp, one.Fold() == two.Fold())
+			eq(exp, one.Compare(two) == 0)
+		}
+
+		test(true, ``, ``)
+		test(true, `one`, `one`)
+		test(true, `One`, `oNE`)
+		test(true, `ÄÖÜ`, `äöü`)
+		test(true, `Σίσυφος`, `ΣΊΣΥΦΟΣ`)
+		test(true, `kelvin`, "\u212aelvin")
+		test(true, `ſ`, `S`)
+		test(false, `one`, `two`)
+		test(false, `one`, `one `)
+		test(false, `one`, ``)
+		test(false, "\u0130", `i`)
+		test(false, "\u0130", `I`)
+		test(false, "\u0131", `i`)
+		test(true, "\u0130stanbul", "\u0130STANBUL")
+	})
+
+	t.Run(`Fold`, func(t *testing.T) {
+		eq(``, gt.NullCiString(``).Fold())
+		eq(`hello world`, gt.NullCiString(`Hello World`).Fold())
+		eq(`äöü`, gt.NullCiString(`ÄÖÜ`).Fold())
+		eq(`kelvin`, gt.NullCiString("\u212aelvin").Fold())
+		eq("\u0130stanbul", gt.NullCiString("\u0130STANBUL").Fold())
+
+		src := `already folded`
+		eq(float64(0), testing.AllocsPerRun(16, func() {
+			_ = gt.NullCiString(src).Fold()
+		}))
+	})
+
+	t.Run(`Compare`, func(t *testing.T) {
+		list := []gt.NullCiString{`banana`, `Cherry`, `apple`, `Banana`, `APPLE`}
+		sort.SliceStable(list, func(one, two int) bool {
+			return list[one].Compare(list[two]) < 0
+		})
+		eq([]gt.NullCiString{`apple`, `APPLE`, `banana`, `Banana`, `Cherry`}, list)
+	})
+}
@@ -0,0 +1,170 @@
+package gt
+
+import "database/sql/driver"
+
+/*
+Shortcut: parses successfully or panics. Should be used only in root scope. When
+error handling is relevant, use `.Parse`.
+*/
+func ParseNullCron(src string) (val NullCron) {
+	try(val.Parse(src))
+	return
+}
+
+/*
+Variant of `gt.Cron` where zero value is considered empty in text, and null in
+JSON and SQL. Suitable for nullable text columns storing job schedules: invalid
+expressions are rejected at `.Parse` / `.Scan` time.
+*/
+type NullCron Cron
+
+var (
+	_ = Encodable(NullCron{})
+	_ = Decodable((*NullCron)(nil))
+)
+
+// Implement `gt.Zeroable`. Equivalent to `reflect.ValueOf(self).IsZero()`.
+func (self NullCron) IsZero() bool { return Cron(self).IsZero() }
+
+// Implement `gt.Nullable`. True if zero.
+func (self NullCron) IsNull() bool { return self.IsZero() }
+
+/*
+Implement `gt.Getter`. If zero, returns `nil`, otherwise uses `.String` to
+return a string representation.
+*/
+func (self NullCron) Get() any {
+	if self.IsNull() {
+		return nil
+	}
+	return Cron(self).Get()
+}
+
+// Implement `gt.Setter`, using `.Scan`. Panics on error.
+func (self *NullCron) Set(src any) { try(self.Scan(src)) }
+
+// Implement `gt.Zeroer`, zeroing the receiver.
+func (self *NullCron) Zero() { (*Cron)(self).Zero() }
+
+/*
+Implement `fmt.Stringer`. If zero, returns an empty string. Otherwise returns
+the same representation as `gt.Cron.String`.
+*/
+func (self NullCron) String() string { return Cron(self).String() }
+
+/*
+Implement `gt.Parser`. If the input is empty, zeroes the receiver. Otherwise
+requires a valid cron expression.
+*/
+func (self *NullCron) Parse(src string) error {
+	if len(src) <= 0 {
+		self.Zero()
+		return nil
+	}
+	return (*Cron)(self).Parse(src)
+}
+
+// Implement `gt.AppenderTo`, using the same representation as `.String`.
+func (self NullCron) AppendTo(buf []byte) []byte {
+	return Cron(self).AppendTo(buf)
+}
+
+/*
+Implement `encoding.TextMarhaler`. If zero, returns nil. Otherwise returns the
+same representation as `.String`.
+*/
+func (self NullCron) MarshalText() ([]byte, error) {
+	if self.IsNull() {
+		return nil, nil
+	}
+	return Cron(self).MarshalText()
+}
+
+// Implement `encoding.TextUnmarshaler`, using the same algorithm as `.Parse`.
+func (self *NullCron) UnmarshalText(src []byte) error {
+	return self.Parse(bytesString(src))
+}
+
+/*
+Implement `json.Marshaler`. If zero, returns bytes representing `null`.
+Otherwise returns bytes representing a JSON string with the same text as in
+`.String`.
+*/
+func (self NullCron) MarshalJSON() ([]byte, error) {
+	if self.IsNull() {
+		return bytesNull, nil
+	}
+	return Cron(self).MarshalJSON()
+}
+
+/*
+Implement `json.Unmarshaler`. If the input is empty or represents JSON `null`,
+zeroes the receiver. Otherwise parses a JSON string, using the same algorithm
+as `.Parse`.
+*/
+func (self *NullCron) UnmarshalJSON(src []byte) error {
+	if isJsonEmpty(src) {
+		self.Zero()
+		return nil
+	}
+
+	if isJsonStr(src) {
+		return self.UnmarshalText(cutJsonStr(src))
+	}
+
+	return errJsonString(src, self)
+}
+
+// Implement `driver.Valuer`, using `.Get`.
+func (self NullCron) Value() (driver.Value, error) {
+	return self.Get(), nil
+}
+
+/*
+Implement `sql.Scanner`, converting an arbitrary input to `gt.NullCron` and
+modifying the receiver. Acceptable inputs:
+
+  - `nil`         -> use `.Zero`
+  - `string`      -> use `.Parse`
+  - `[]byte`      -> use `.UnmarshalText`
+  - `gt.Cron`     -> assign
+  - `gt.NullCron` -> assign
+  - `gt.Getter`   -> scan underlying value
+*/
+func (self *NullCron) Scan(src any) error {
+	switch src := src.(type) {
+	case nil:
+		self.Zero()
+		return nil
+
+	case string:
+		return self.Parse(src)
+
+	case []byte:
+		return self.UnmarshalText(src)
+
+	case Cron:
+		*self = NullCron(src)
+		return nil
+
+	case NullCron:
+		*self = src
+		return nil
+
+	default:
+		val, ok := get(src)
+		if ok {
+			return self.Scan(val)
+		}
+		return errScanType(self, src)
+	}
+}
+
+// Same as `gt.Cron.Match`.
+func (self NullCron) Match(val NullTime) bool { return Cron(self).Match(val) }
+
+// Same as `gt.Cron.Next`. If zero, returns zero.
+func (self NullCron) Next(val NullTime) NullTime { return Cron(self).Next(val) }
+
+// Same as `gt.Cron.Prev`. If zero, returns zero.
+func (self NullCron) Prev(val NullTime) NullTime { return Cron(self).Prev(val) }
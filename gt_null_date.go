@@ -3,6 +3,7 @@ package gt
 import (
 	"database/sql/driver"
 	"fmt"
+	"math"
 	"time"
 )
 
@@ -72,13 +73,17 @@ func (self NullDate) IsZero() bool { return self == NullDate{} }
 func (self NullDate) IsNull() bool { return self.IsZero() }
 
 /*
-Implement `gt.Getter`. If zero, returns `nil`, otherwise uses `.TimeUTC` to
-return a timestamp suitable for SQL encoding.
+Implement `gt.Getter`. If zero, returns `nil`. If infinite, returns a string
+understood by Postgres. Otherwise uses `.TimeUTC` to return a timestamp
+suitable for SQL encoding.
 */
 func (self NullDate) Get() any {
 	if self.IsNull() {
 		return nil
 	}
+	if self.IsInfinite() {
+		return self.String()
+	}
 	return self.TimeUTC()
 }
 
@@ -109,6 +114,7 @@ requires an ISO 8601 date representation, one of:
 
   - Extended calendar date: "2006-01-02"
   - RFC3339 (default Go timestamp format): "2006-01-02T15:04:05Z07:00"
+  - "infinity" or "-infinity" (case-insensitive, like in Postgres)
 */
 func (self *NullDate) Parse(src string) error {
 	if len(src) <= 0 {
@@ -116,14 +122,32 @@ func (self *NullDate) Parse(src string) error {
 		return nil
 	}
 
+	switch infinitySign(src) {
+	case 1:
+		*self = NullDateInfinity
+		return nil
+	case -1:
+		*self = NullDateNegInfinity
+		return nil
+	}
+
 	var val time.Time
 	var err error
 
 	// Too restrictive. TODO fuzzier detection.
 	if len(src) == len(dateFormat) {
-		val, err = time.Parse(dateFormat, src)
+		year, month, day, ok := parseDateFast(src)
+		if ok {
+			val = time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+		} else {
+			val, err = time.Parse(dateFormat, src)
+		}
 	} else {
-		val, err = time.Parse(timeFormat, src)
+		var ok bool
+		val, ok = parseTimeFast(src)
+		if !ok {
+			val, err = time.Parse(timeFormat, src)
+		}
 	}
 	if err != nil {
 		return err
@@ -133,15 +157,35 @@ func (self *NullDate) Parse(src string) error {
 	return nil
 }
 
+/*
+Variant of `.Parse` that uses the given `gt.TimeParser`, allowing non-strict
+formats such as RFC 1123 or Postgres `timestamp`. On error, the receiver is
+unchanged.
+*/
+func (self *NullDate) ParseWith(src string, parser TimeParser) error {
+	val, err := parser.ParseNullDate(src)
+	if err != nil {
+		return err
+	}
+	*self = val
+	return nil
+}
+
 // Implement `gt.AppenderTo`, using the same representation as `.String`.
 func (self NullDate) AppendTo(buf []byte) []byte {
 	if self.IsNull() {
 		return buf
 	}
+	if sign := self.infinitySign(); sign != 0 {
+		return append(buf, infinityString(sign)...)
+	}
 
 	// `time.Time.AppendFormat` doesn't seem to do this.
 	buf = Raw(buf).Grow(dateStrLen)
 
+	if self.isValidFast() {
+		return appendDateFast(buf, self.Year, self.Month, self.Day)
+	}
 	return self.TimeUTC().AppendFormat(buf, dateFormat)
 }
 
@@ -162,14 +206,18 @@ func (self *NullDate) UnmarshalText(src []byte) error {
 }
 
 /*
-Implement `json.Marshaler`. If zero, returns bytes representing `null`.
-Otherwise returns bytes representing a JSON string with the same text as in
-`.String`.
+Implement `json.Marshaler`. If zero, returns bytes representing `null`. If
+infinite, returns "infinity" or "-infinity" as a JSON string; for other modes,
+see `gt.InfinityJson`. Otherwise returns bytes representing a JSON string with
+the same text as in `.String`.
 */
 func (self NullDate) MarshalJSON() ([]byte, error) {
 	if self.IsNull() {
 		return bytesNull, nil
 	}
+	if sign := self.infinitySign(); sign != 0 {
+		return InfinityJsonString.json(sign, dateInfinityBound), nil
+	}
 
 	var arr [dateStrLen + 2]byte
 	buf := arr[:0]
@@ -258,6 +306,13 @@ func (self *NullDate) Scan(src any) error {
 
 // Implement `fmt.GoStringer`, returning valid Go code that constructs this value.
 func (self NullDate) GoString() string {
+	switch self.infinitySign() {
+	case 1:
+		return `gt.NullDateInfinity`
+	case -1:
+		return `gt.NullDateNegInfinity`
+	}
+
 	year, month, day := self.Date()
 	return fmt.Sprintf(`gt.NullDateFrom(%v, %v, %v)`, year, int(month), day)
 }
@@ -272,7 +327,7 @@ func (self *NullDate) SetTime(src time.Time) {
 	if src.IsZero() {
 		self.Zero()
 	} else {
-		*self = NullDateFrom(src.Date())
+		*self = NullTime(src).NullDate()
 	}
 }
 
@@ -281,9 +336,19 @@ func (self NullDate) Date() (year int, month time.Month, day int) {
 	return self.Year, self.Month, self.Day
 }
 
-// Converts to `gt.NullTime` with `T00:00:00` in the provided timezone.
+/*
+Converts to `gt.NullTime` with `T00:00:00` in the provided timezone. Infinite
+dates are converted to infinite timestamps.
+*/
 func (self NullDate) NullTimeIn(loc *time.Location) NullTime {
-	return NullTime(time.Date(self.Year, self.Month, self.Day, 0, 0, 0, 0, loc))
+	switch self.infinitySign() {
+	case 1:
+		return NullTimeInfinity
+	case -1:
+		return NullTimeNegInfinity
+	default:
+		return NullTime(time.Date(self.Year, self.Month, self.Day, 0, 0, 0, 0, loc))
+	}
 }
 
 // Converts to `gt.NullTime` with `T00:00:00` in UTC.
@@ -291,12 +356,16 @@ func (self NullDate) NullTimeUTC() NullTime {
 	return self.NullTimeIn(time.UTC)
 }
 
-// Converts to `time.Time` with `T00:00:00` in the provided timezone.
+/*
+Converts to `time.Time` with `T00:00:00` in the provided timezone. Infinite
+dates are converted to the times of `gt.NullTimeInfinity` and
+`gt.NullTimeNegInfinity`, since their years are out of range for `time.Date`.
+*/
 func (self NullDate) TimeIn(loc *time.Location) time.Time {
 	return self.NullTimeIn(loc).Time()
 }
 
-// Converts to `time.Time` with `T00:00:00` in UTC.
+// Same as `.TimeIn` with UTC.
 func (self NullDate) TimeUTC() time.Time {
 	return self.NullTimeUTC().Time()
 }
@@ -314,8 +383,230 @@ of operations involving nulls. Note that the equivalent of zero TIME is not
 `gt.NullDateFrom(0, 0, 0)`, but rather `gt.NullDateFrom(1, 1, 1)`.
 */
 func (self NullDate) AddDate(years int, months int, days int) NullDate {
-	if self.IsZero() {
+	if self.IsZero() || self.IsInfinite() {
 		return self
 	}
 	return NullDateFrom(self.NullTimeUTC().AddDate(years, months, days).Date())
 }
+
+/*
+Similar to `gt.NullTime.AddInterval`. Adds the interval to the date, returning
+the modified date. The time portion of the interval is applied to midnight in
+UTC, and any resulting time of day is discarded. For example, adding 36 hours
+adds one day.
+
+As a special case, calling this on a zero date ALWAYS returns the same zero
+date. See `gt.NullDate.AddDate` for details.
+*/
+func (self NullDate) AddInterval(val Interval) NullDate {
+	if self.IsZero() || self.IsInfinite() {
+		return self
+	}
+	return NullDateFrom(self.NullTimeUTC().AddInterval(val).Date())
+}
+
+/*
+Subtracts the given interval, returning the modified date.
+Inverse of `NullDate.AddInterval`.
+*/
+func (self NullDate) SubInterval(val Interval) NullDate {
+	return self.AddInterval(val.Neg())
+}
+
+// Same as `gt.NullDate.AddInterval` but for `gt.NullInterval`.
+func (self NullDate) AddNullInterval(val NullInterval) NullDate {
+	return self.AddInterval(Interval(val))
+}
+
+// Same as `gt.NullDate.SubInterval` but for `gt.NullInterval`.
+func (self NullDate) SubNullInterval(val NullInterval) NullDate {
+	return self.AddNullInterval(val.Neg())
+}
+
+/*
+Compares two dates, returning -1 if the receiver is earlier, 1 if the receiver
+is later, and 0 if they're equal. Dates are normalized before comparison, just
+like in `time.Date`, so `gt.NullDateFrom(2024, 2, 30)` is equal to
+`gt.NullDateFrom(2024, 3, 1)`. The zero date is earlier than any non-zero date
+with a non-negative year. `gt.NullDateNegInfinity` is earlier than any other
+date, and `gt.NullDateInfinity` is later.
+*/
+func (self NullDate) Compare(val NullDate) int {
+	if one, two := self.infinitySign(), val.infinitySign(); one != 0 || two != 0 {
+		if one < two {
+			return -1
+		}
+		if one > two {
+			return 1
+		}
+		return 0
+	}
+
+	one, two := self.TimeUTC(), val.TimeUTC()
+	if one.Before(two) {
+		return -1
+	}
+	if one.After(two) {
+		return 1
+	}
+	return 0
+}
+
+/*
+Alias for `gt.NullDate.Before`. Also see `gt.NullDateLess` which is variadic.
+*/
+func (self NullDate) Less(val NullDate) bool { return self.Before(val) }
+
+// Equivalent to `self.Equal(val) || self.Less(val)`.
+func (self NullDate) LessOrEqual(val NullDate) bool { return self.Compare(val) <= 0 }
+
+// Date version of `time.Time.Before`.
+func (self NullDate) Before(val NullDate) bool { return self.Compare(val) < 0 }
+
+// Date version of `time.Time.After`.
+func (self NullDate) After(val NullDate) bool { return self.Compare(val) > 0 }
+
+/*
+Date version of `time.Time.Equal`. Unlike `==`, compares normalized dates. See
+`gt.NullDate.Compare`.
+*/
+func (self NullDate) Equal(val NullDate) bool { return self.Compare(val) == 0 }
+
+/*
+Returns the number of days from the receiver to the given date, which is
+negative if the given date is earlier. If either date is zero, returns 0. If
+either date is infinite, returns `math.MaxInt` or `math.MinInt` depending on
+the order of the dates, or 0 if they're the same infinity. Inverse of
+`gt.NullDate.DaysSince`.
+*/
+func (self NullDate) DaysUntil(val NullDate) int {
+	if self.IsZero() || val.IsZero() {
+		return 0
+	}
+
+	if one, two := self.infinitySign(), val.infinitySign(); one != 0 || two != 0 {
+		switch {
+		case one < two:
+			return math.MaxInt
+		case one > two:
+			return math.MinInt
+		default:
+			return 0
+		}
+	}
+
+	const daySecs = 24 * 60 * 60
+	return int((val.TimeUTC().Unix() - self.TimeUTC().Unix()) / daySecs)
+}
+
+/*
+Returns the number of days from the given date to the receiver, which is
+negative if the given date is later. If either date is zero, returns 0.
+Inverse of `gt.NullDate.DaysUntil`.
+*/
+func (self NullDate) DaysSince(val NullDate) int { return val.DaysUntil(self) }
+
+// Date version of `time.Time.Weekday`.
+func (self NullDate) Weekday() time.Weekday { return self.TimeUTC().Weekday() }
+
+// Date version of `time.Time.YearDay`.
+func (self NullDate) YearDay() int { return self.TimeUTC().YearDay() }
+
+// Date version of `time.Time.ISOWeek`. Returns the ISO 8601 year and week number.
+func (self NullDate) IsoWeek() (year, week int) { return self.TimeUTC().ISOWeek() }
+
+/*
+Returns the first day of the date's month. If zero, returns zero. Infinity
+remains unchanged.
+*/
+func (self NullDate) StartOfMonth() NullDate {
+	if self.IsZero() || self.IsInfinite() {
+		return self
+	}
+	year, month, _ := self.TimeUTC().Date()
+	return NullDateFrom(year, month, 1)
+}
+
+/*
+Returns the last day of the date's month. If zero, returns zero. Infinity
+remains unchanged.
+*/
+func (self NullDate) EndOfMonth() NullDate {
+	if self.IsZero() || self.IsInfinite() {
+		return self
+	}
+	return self.StartOfMonth().AddDate(0, 1, -1)
+}
+
+/*
+Returns the first day of the date's year. If zero, returns zero. Infinity
+remains unchanged.
+*/
+func (self NullDate) StartOfYear() NullDate {
+	if self.IsZero() || self.IsInfinite() {
+		return self
+	}
+	return NullDateFrom(self.TimeUTC().Year(), time.January, 1)
+}
+
+/*
+True if the dates are ordered like this: A < B < C < ....
+Also see `gt.NullDateLessOrEqual` which uses `<=`.
+*/
+func NullDateLess(val ...NullDate) bool {
+	return NullDateOrder(val, NullDate.Less)
+}
+
+/*
+True if the dates are ordered like this: A <= B <= C <= ....
+Also see `gt.NullDateLess` which uses `<`.
+*/
+func NullDateLessOrEqual(val ...NullDate) bool {
+	return NullDateOrder(val, NullDate.LessOrEqual)
+}
+
+/*
+True if the dates are ordered in such a way that the given function returns
+true for each subsequent pair. If the function is nil, returns false.
+Otherwise, if length is 0 or 1, returns true.
+*/
+func NullDateOrder(src []NullDate, fun func(NullDate, NullDate) bool) bool {
+	if fun == nil {
+		return false
+	}
+
+	for len(src) > 1 {
+		if !fun(src[0], src[1]) {
+			return false
+		}
+		src = src[1:]
+	}
+	return true
+}
+
+/*
+True if the date can be encoded by `appendDateFast` without normalization.
+Other dates, such as 2024-02-30, are normalized by `time.Date`.
+*/
+func (self NullDate) isValidFast() bool {
+	return self.Year >= 0 && self.Year <= 9999 &&
+		self.Month >= time.January && self.Month <= time.December &&
+		self.Day >= 1 && self.Day <= daysInMonthFast(self.Year, self.Month)
+}
+
+/*
+True if the date is `gt.NullDateInfinity` or `gt.NullDateNegInfinity`,
+corresponding to Postgres `infinity` and `-infinity`.
+*/
+func (self NullDate) IsInfinite() bool { return self.infinitySign() != 0 }
+
+func (self NullDate) infinitySign() int {
+	switch self {
+	case NullDateInfinity:
+		return 1
+	case NullDateNegInfinity:
+		return -1
+	default:
+		return 0
+	}
+}
@@ -18,6 +18,29 @@ func TestNullDate(t *testing.T) {
 		eq(``, gt.NullDateFrom(0, 0, 0).String())
 		eq(`0001-01-01`, gt.NullDateFrom(1, 1, 1).String())
 		eq(`0000-12-31`, gt.NullDateFrom(1, 1, 0).String())
+		eq(`2024-03-01`, gt.NullDateFrom(2024, 2, 30).String())
+		eq(`2024-02-29`, gt.NullDateFrom(2024, 2, 29).String())
+		eq(`9999-12-31`, gt.NullDateFrom(9999, 12, 31).String())
+		eq(`10000-01-01`, gt.NullDateFrom(10000, 1, 1).String())
+	})
+
+	t.Run(`Parse`, func(t *testing.T) {
+		test := func(exp gt.NullDate, src string) {
+			t.Helper()
+			eq(exp, gt.ParseNullDate(src))
+		}
+
+		test(gt.NullDate{}, ``)
+		test(gt.NullDate{}, `0001-01-01`)
+		test(gt.NullDateFrom(2024, 2, 29), `2024-02-29`)
+		test(gt.NullDateFrom(2024, 5, 1), `2024-05-01T23:00:00-05:00`)
+
+		var val gt.NullDate
+		fail(val.Parse(`2023-02-29`))
+		fail(val.Parse(`2024-00-01`))
+		fail(val.Parse(`2024-1-001`))
+		fail(val.Parse(`2024-01-01T`))
+		eq(gt.NullDate{}, val)
 	})
 
 	t.Run(`AddDate`, func(t *testing.T) {
@@ -29,4 +52,68 @@ func TestNullDate(t *testing.T) {
 		eq(gt.NullDateFrom(5, 7, 9), gt.NullDateFrom(4, 5, 6).AddDate(1, 2, 3))
 		eq(gt.NullDateFrom(3, 2, 1), gt.NullDateFrom(4, 5, 6).AddDate(-1, -3, -5))
 	})
+	t.Run(`AddInterval`, func(t *testing.T) {
+		eq(gt.NullDate{}, gt.NullDate{}.AddInterval(gt.DateInterval(1, 2, 3)))
+		eq(gt.NullDateFrom(5, 7, 9), gt.NullDateFrom(1, 2, 3).AddInterval(gt.DateInterval(4, 5, 6)))
+		eq(gt.NullDateFrom(3, 2, 1), gt.NullDateFrom(4, 5, 6).AddInterval(gt.DateInterval(-1, -3, -5)))
+		eq(gt.NullDateFrom(1, 2, 4), gt.NullDateFrom(1, 2, 3).AddInterval(gt.TimeInterval(36, 0, 0)))
+		eq(gt.NullDateFrom(1, 2, 1), gt.NullDateFrom(1, 2, 3).SubInterval(gt.TimeInterval(36, 0, 0)))
+	})
+
+	t.Run(`Compare`, func(t *testing.T) {
+		eq(0, gt.NullDate{}.Compare(gt.NullDate{}))
+		eq(-1, gt.NullDate{}.Compare(gt.NullDateFrom(1, 1, 1)))
+		eq(1, gt.NullDateFrom(1, 1, 1).Compare(gt.NullDate{}))
+		eq(0, gt.NullDateFrom(2024, 2, 30).Compare(gt.NullDateFrom(2024, 3, 1)))
+		eq(-1, gt.NullDateFrom(2024, 2, 29).Compare(gt.NullDateFrom(2024, 3, 1)))
+		eq(1, gt.NullDateFrom(2025, 1, 1).Compare(gt.NullDateFrom(2024, 12, 31)))
+
+		eq(true, gt.NullDateFrom(2024, 2, 30).Equal(gt.NullDateFrom(2024, 3, 1)))
+		eq(true, gt.NullDateFrom(2024, 2, 29).Before(gt.NullDateFrom(2024, 3, 1)))
+		eq(false, gt.NullDateFrom(2024, 3, 1).Before(gt.NullDateFrom(2024, 3, 1)))
+		eq(true, gt.NullDateFrom(2024, 3, 1).After(gt.NullDateFrom(2024, 2, 29)))
+		eq(true, gt.NullDateFrom(2024, 3, 1).LessOrEqual(gt.NullDateFrom(2024, 3, 1)))
+	})
+
+	t.Run(`DaysUntil`, func(t *testing.T) {
+		eq(0, gt.NullDate{}.DaysUntil(gt.NullDateFrom(2024, 1, 1)))
+		eq(0, gt.NullDateFrom(2024, 1, 1).DaysUntil(gt.NullDateFrom(2024, 1, 1)))
+		eq(60, gt.NullDateFrom(2024, 1, 1).DaysUntil(gt.NullDateFrom(2024, 3, 1)))
+		eq(-60, gt.NullDateFrom(2024, 3, 1).DaysUntil(gt.NullDateFrom(2024, 1, 1)))
+		eq(146097, gt.NullDateFrom(1600, 1, 1).DaysUntil(gt.NullDateFrom(2000, 1, 1)))
+		eq(60, gt.NullDateFrom(2024, 3, 1).DaysSince(gt.NullDateFrom(2024, 1, 1)))
+	})
+
+	t.Run(`calendar`, func(t *testing.T) {
+		eq(time.Thursday, gt.NullDateFrom(2024, 2, 29).Weekday())
+		eq(60, gt.NullDateFrom(2024, 2, 29).YearDay())
+		eq(list(2025, 1), list(gt.NullDateFrom(2024, 12, 30).IsoWeek()))
+
+		eq(gt.NullDate{}, gt.NullDate{}.StartOfMonth())
+		eq(gt.NullDate{}, gt.NullDate{}.EndOfMonth())
+		eq(gt.NullDate{}, gt.NullDate{}.StartOfYear())
+		eq(gt.NullDateFrom(2024, 2, 1), gt.NullDateFrom(2024, 2, 17).StartOfMonth())
+		eq(gt.NullDateFrom(2024, 2, 29), gt.NullDateFrom(2024, 2, 17).EndOfMonth())
+		eq(gt.NullDateFrom(2023, 2, 28), gt.NullDateFrom(2023, 2, 17).EndOfMonth())
+		eq(gt.NullDateFrom(2024, 12, 31), gt.NullDateFrom(2024, 12, 1).EndOfMonth())
+		eq(gt.NullDateFrom(2024, 1, 1), gt.NullDateFrom(2024, 2, 17).StartOfYear())
+	})
+}
+
+func TestNullDateLess(t *testing.T) {
+	test := func(exp bool, src ...gt.NullDate) {
+		t.Helper()
+		eq(exp, gt.NullDateLess(src...))
+	}
+
+	test(true)
+	test(true, gt.NullDate{})
+	test(false, gt.NullDate{}, gt.NullDate{})
+	test(true, gt.NullDate{}, gt.NullDateFrom(1, 1, 2))
+	test(false, gt.NullDateFrom(1, 1, 2), gt.NullDateFrom(1, 1, 2))
+	test(true, gt.NullDateFrom(1, 1, 2), gt.NullDateFrom(1, 1, 3), gt.NullDateFrom(1, 1, 4))
+	test(false, gt.NullDateFrom(1, 1, 2), gt.NullDate{}, gt.NullDateFrom(1, 1, 3))
+
+	eq(true, gt.NullDateLessOrEqual(gt.NullDateFrom(1, 1, 2), gt.NullDateFrom(1, 1, 2), gt.NullDateFrom(1, 1, 3)))
+	eq(false, gt.NullDateOrder([]gt.NullDate{gt.NullDateFrom(1, 1, 2)}, nil))
 }
@@ -0,0 +1,470 @@
+package gt
+
+import (
+	"database/sql/driver"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+/*
+Shortcut: parses successfully or panics. Should be used only in root scope. When
+error handling is relevant, use `.Parse`.
+*/
+func ParseNullDuration(src string) (val NullDuration) {
+	try(val.Parse(src))
+	return
+}
+
+/*
+Variant of `time.Duration` where zero value is considered empty in text, and
+null in JSON and SQL. Text and JSON use the Go duration syntax of
+`time.Duration.String`, such as "1h30m0s". Decoding is more lenient, see
+`.Parse`.
+
+In SQL, `.Value` returns the number of nanoseconds as `int64`, which is also the
+default behavior of "database/sql" for `time.Duration`, and is suitable for
+`bigint` columns. Accordingly, `.Scan` treats integer text as nanoseconds,
+because some drivers return `bigint` as text. `.Scan` additionally accepts
+Postgres `interval` in the
+default output format and in ISO 8601. To encode an `interval`, convert via
+`.Interval` or `.NullInterval`.
+
+Unlike `gt.Interval`, this type has no notion of days, months, or years. When
+decoding, a day is treated as 24 hours, while years and months are rejected.
+*/
+type NullDuration time.Duration
+
+var (
+	_ = Encodable(NullDuration(0))
+	_ = Decodable((*NullDuration)(nil))
+)
+
+// Implement `gt.Zeroable`. True if 0.
+func (self NullDuration) IsZero() bool { return self == 0 }
+
+// Implement `gt.Nullable`. True if 0.
+func (self NullDuration) IsNull() bool { return self.IsZero() }
+
+// Implement `gt.PtrGetter`, returning `*time.Duration`.
+func (self *NullDuration) GetPtr() any { return (*time.Duration)(self) }
+
+/*
+Implement `gt.Getter`. If zero, returns `nil`, otherwise returns the number of
+nanoseconds as `int64`.
+*/
+func (self NullDuration) Get() any {
+	if self.IsNull() {
+		return nil
+	}
+	return int64(self)
+}
+
+// Implement `gt.Setter`, using `.Scan`. Panics on error.
+func (self *NullDuration) Set(src any) { try(self.Scan(src)) }
+
+// Implement `gt.Zeroer`, zeroing the receiver.
+func (self *NullDuration) Zero() {
+	if self != nil {
+		*self = 0
+	}
+}
+
+/*
+Implement `fmt.Stringer`. If zero, returns an empty string. Otherwise formats
+using `time.Duration.String`.
+*/
+func (self NullDuration) String() string {
+	if self.IsNull() {
+		return ``
+	}
+	return time.Duration(self).String()
+}
+
+/*
+Implement `gt.Parser`. If the input is empty, zeroes the receiver. Otherwise
+accepts the following formats:
+
+  - Go duration syntax:        "1h30m", "-1.5s", "300ms".
+  - ISO 8601 interval:         "PT1H30M", "P1DT12H".
+  - Postgres interval output:  "01:30:00", "1 day 02:03:04.5", "-00:00:01".
+  - Number:                    seconds, such as "90" or "1.5".
+
+In ISO 8601 and Postgres inputs, a day is treated as 24 hours, while years and
+months are rejected because their length varies. Values outside the range of
+`time.Duration` are rejected.
+*/
+func (self *NullDuration) Parse(src string) (err error) {
+	if len(src) <= 0 {
+		self.Zero()
+		return nil
+	}
+
+	defer errParse(&err, src, `duration`)
+
+	val, err := parseDuration(src)
+	if err != nil {
+		return err
+	}
+
+	*self = NullDuration(val)
+	return nil
+}
+
+// Implement `gt.AppenderTo`, using the same representation as `.String`.
+func (self NullDuration) AppendTo(buf []byte) []byte {
+	if self.IsNull() {
+		return buf
+	}
+	return append(buf, time.Duration(self).String()...)
+}
+
+/*
+Implement `encoding.TextMarhaler`. If zero, returns nil. Otherwise returns the
+same representation as `.String`.
+*/
+func (self NullDuration) MarshalText() ([]byte, error) {
+	if self.IsNull() {
+		return nil, nil
+	}
+	return self.AppendTo(nil), nil
+}
+
+// Implement `encoding.TextUnmarshaler`, using the same algorithm as `.Parse`.
+func (self *NullDuration) UnmarshalText(src []byte) error {
+	return self.Parse(bytesString(src))
+}
+
+/*
+Implement `json.Marshaler`. If zero, returns bytes representing `null`.
+Otherwise returns bytes representing a JSON string with the same text as in
+`.String`.
+*/
+func (self NullDuration) MarshalJSON() ([]byte, error) {
+	if self.IsNull() {
+		return bytesNull, nil
+	}
+
+	buf := make([]byte, 0, 32)
+	buf = append(buf, '"')
+	buf = self.AppendTo(buf)
+	buf = append(buf, '"')
+	return buf, nil
+}
+
+/*
+Implement `json.Unmarshaler`. If the input is empty or represents JSON `null`,
+zeroes the receiver. If the input is a JSON string, uses `.Parse`. Also accepts
+JSON numbers, which are always seconds, such as 90 or 1.5.
+*/
+func (self *NullDuration) UnmarshalJSON(src []byte) error {
+	if isJsonEmpty(src) {
+		self.Zero()
+		return nil
+	}
+	if isJsonStr(src) {
+		return self.UnmarshalText(cutJsonStr(src))
+	}
+	if len(src) > 0 && (charsetDigitDec.has(src[0]) || src[0] == '-') {
+		return self.UnmarshalText(src)
+	}
+	return errJsonDuration(src)
+}
+
+// Implement `driver.Valuer`, using `.Get`.
+func (self NullDuration) Value() (driver.Value, error) {
+	return self.Get(), nil
+}
+
+/*
+Implement `sql.Scanner`, converting an arbitrary input to `gt.NullDuration` and
+modifying the receiver. Acceptable inputs:
+
+  - `nil`             -> use `.Zero`
+  - `string`          -> integer as nanoseconds, otherwise use `.Parse`
+  - `[]byte`          -> integer as nanoseconds, otherwise use `.Parse`
+  - `int64`           -> assign as nanoseconds, matching `.Value`
+  - `float64`         -> assign as seconds
+  - `time.Duration`   -> assign
+  - `gt.NullDuration` -> assign
+  - `gt.Interval`     -> convert, rejecting years and months
+  - `gt.NullInterval` -> convert, rejecting years and months
+  - `gt.Getter`       -> scan underlying value
+*/
+func (self *NullDuration) Scan(src any) error {
+	switch src := src.(type) {
+	case nil:
+		self.Zero()
+		return nil
+
+	case string:
+		return self.scanText(src)
+
+	case []byte:
+		return self.scanText(bytesString(src))
+
+	case int64:
+		*self = NullDuration(src)
+		return nil
+
+	case float64:
+		val, err := secondsDuration(src)
+		if err != nil {
+			return errScanDuration(src, err)
+		}
+		*self = NullDuration(val)
+		return nil
+
+	case time.Duration:
+		*self = NullDuration(src)
+		return nil
+
+	case NullDuration:
+		*self = src
+		return nil
+
+	case Interval:
+		val, err := intervalDuration(src)
+		if err != nil {
+			return errScanDuration(&src, err)
+		}
+		*self = NullDuration(val)
+		return nil
+
+	case NullInterval:
+		return self.Scan(Interval(src))
+
+	default:
+		val, ok := get(src)
+		if ok {
+			return self.Scan(val)
+		}
+		return errScanType(self, src)
+	}
+}
+
+/*
+Unlike `.Parse`, treats integer text as nanoseconds, matching `.Value`, because
+some drivers return `bigint` columns as text.
+*/
+func (self *NullDuration) scanText(src string) (err error) {
+	if !isIntString(src) {
+		return self.Parse(src)
+	}
+
+	defer errParse(&err, src, `duration`)
+
+	val, err := strconv.ParseInt(src, 10, 64)
+	if err != nil {
+		return errRange
+	}
+
+	*self = NullDuration(val)
+	return nil
+}
+
+// Free cast to the underlying `time.Duration`.
+func (self NullDuration) Duration() time.Duration { return time.Duration(self) }
+
+/*
+Converts to `gt.Interval` via `gt.DurationInterval`, expressed in hours,
+minutes, and seconds. Fractions of a second are truncated.
+*/
+func (self NullDuration) Interval() Interval {
+	return DurationInterval(time.Duration(self))
+}
+
+// Same as `.Interval` but returns `gt.NullInterval`.
+func (self NullDuration) NullInterval() NullInterval {
+	return NullInterval(self.Interval())
+}
+
+func parseDuration(src string) (time.Duration, error) {
+	if src[0] == 'P' {
+		var val Interval
+		if val.parse(src) != nil {
+			return 0, errFormatMismatch
+		}
+		return intervalDuration(val)
+	}
+
+	if isIntString(src) {
+		val, err := strconv.ParseInt(src, 10, 64)
+		if err != nil {
+			return 0, errRange
+		}
+		return durationMul(val, time.Second)
+	}
+
+	if strings.ContainsAny(src, ` :`) {
+		return parsePgInterval(src)
+	}
+
+	val, err := time.ParseDuration(src)
+	if err == nil {
+		return val, nil
+	}
+
+	num, numErr := strconv.ParseFloat(src, 64)
+	if numErr == nil {
+		return secondsDuration(num)
+	}
+	return 0, err
+}
+
+/*
+Parses the default Postgres `interval` output ("postgres" interval style) such as
+"1 day -02:03:04.5", which consists of optional day fields followed by an
+optional clock field.
+*/
+func parsePgInterval(src string) (out time.Duration, err error) {
+	fields := strings.Fields(src)
+	if len(fields) <= 0 {
+		return 0, errFormatMismatch
+	}
+
+	for ind := 0; ind < len(fields); ind++ {
+		field := fields[ind]
+
+		if strings.Contains(field, `:`) {
+			if ind != len(fields)-1 {
+				return 0, errFormatMismatch
+			}
+			val, err := parsePgClock(field)
+			if err != nil {
+				return 0, err
+			}
+			return durationAdd(out, val)
+		}
+
+		if !isIntString(field) || ind+1 >= len(fields) {
+			return 0, errFormatMismatch
+		}
+
+		num, err := strconv.ParseInt(field, 10, 64)
+		if err != nil {
+			return 0, err
+		}
+
+		ind++
+		switch fields[ind] {
+		case `day`, `days`:
+			val, err := durationMul(num, 24*time.Hour)
+			if err != nil {
+				return 0, err
+			}
+			out, err = durationAdd(out, val)
+			if err != nil {
+				return 0, err
+			}
+		case `year`, `years`, `mon`, `mons`:
+			return 0, errDurationYearsMonths
+		default:
+			return 0, errFormatMismatch
+		}
+	}
+	return out, nil
+}
+
+// Parses "[+-]HH:MM[:SS[.ffffff]]".
+func parsePgClock(src string) (time.Duration, error) {
+	neg := false
+	if len(src) > 0 && (src[0] == '-' || src[0] == '+') {
+		neg = src[0] == '-'
+		src = src[1:]
+	}
+
+	parts := strings.Split(src, `:`)
+	if len(parts) < 2 || len(parts) > 3 {
+		return 0, errFormatMismatch
+	}
+	for _, part := range parts[:2] {
+		if !isDigitString(part) {
+			return 0, errFormatMismatch
+		}
+	}
+
+	hours, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		return 0, err
+	}
+	mins, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return 0, err
+	}
+
+	out, err := durationMul(hours, time.Hour)
+	if err != nil {
+		return 0, err
+	}
+	val, err := durationMul(mins, time.Minute)
+	if err != nil {
+		return 0, err
+	}
+	out, err = durationAdd(out, val)
+	if err != nil {
+		return 0, err
+	}
+
+	if len(parts) == 3 {
+		secs := parts[2]
+		if len(secs) <= 0 || !charsetDigitDec.has(secs[0]) {
+			return 0, errFormatMismatch
+		}
+		val, err := time.ParseDuration(secs + `s`)
+		if err != nil {
+			return 0, err
+		}
+		out, err = durationAdd(out, val)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	if neg {
+		return -out, nil
+	}
+	return out, nil
+}
+
+func isDigitString(src string) bool {
+	return len(src) > 0 && isIntString(src) && charsetDigitDec.has(src[0])
+}
+
+func secondsDuration(src float64) (time.Duration, error) {
+	val := math.Round(src * float64(time.Second))
+	if math.IsNaN(val) || val >= math.MaxInt64 || val < math.MinInt64 {
+		return 0, errRange
+	}
+	return time.Duration(val), nil
+}
+
+func intervalDuration(src Interval) (time.Duration, error) {
+	if src.Years != 0 || src.Months != 0 {
+		return 0, errDurationYearsMonths
+	}
+
+	out, err := durationMul(int64(src.Days), 24*time.Hour)
+	if err != nil {
+		return 0, err
+	}
+	return durationAdd(out, src.OnlyTime().Duration())
+}
+
+// Multiplies with overflow checking. The unit must be positive.
+func durationMul(num int64, unit time.Duration) (time.Duration, error) {
+	if num > int64(math.MaxInt64/unit) || num < int64(math.MinInt64/unit) {
+		return 0, errRange
+	}
+	return time.Duration(num) * unit, nil
+}
+
+// Adds with overflow checking.
+func durationAdd(one, two time.Duration) (time.Duration, error) {
+	out := one + two
+	if (two > 0 && out < one) || (two < 0 && out > one) {
+		return 0, errRange
+	}
+	return out, nil
+}
@@ -0,0 +1,146 @@
+package gt_test
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/mitranim/gt"
+)
+
+func TestNullDuration_common(t *testing.T) {
+	var (
+		primZero    = any(nil)
+		primNonZero = int64(time.Hour + 30*time.Minute)
+		textZero    = ``
+		textNonZero = `1h30m0s`
+		jsonZero    = bytesNull
+		jsonNonZero = []byte(`"1h30m0s"`)
+		zero        = gt.NullDuration(0)
+		nonZero     = gt.NullDuration(time.Hour + 30*time.Minute)
+		dec         = new(gt.NullDuration)
+	)
+
+	eq(true, zero.IsNull())
+	eq(false, nonZero.IsNull())
+
+	testAny(t, primZero, primNonZero, textZero, textNonZero, jsonZero, jsonNonZero, zero, nonZero, dec)
+}
+
+func TestNullDuration(t *testing.T) {
+	t.Run(`Parse`, func(t *testing.T) {
+		test := func(exp time.Duration, src string) {
+			t.Helper()
+			eq(gt.NullDuration(exp), gt.ParseNullDuration(src))
+		}
+
+		test(0, ``)
+		test(0, `0`)
+		test(0, `0s`)
+		test(time.Hour+30*time.Minute, `1h30m`)
+		test(-1500*time.Millisecond, `-1.5s`)
+		test(time.Hour+30*time.Minute, `PT1H30M`)
+		test(36*time.Hour, `P1DT12H`)
+		test(time.Hour+30*time.Minute, `01:30:00`)
+		test(time.Hour+30*time.Minute, `01:30`)
+		test(26*time.Hour+3*time.Minute+4500*time.Millisecond, `1 day 02:03:04.5`)
+		test(-24*time.Hour+2*time.Hour, `-1 days +02:00:00`)
+		test(-time.Second, `-00:00:01`)
+		test(3*24*time.Hour, `3 days`)
+		test(1500*time.Second, `1500`)
+		test(-1500*time.Second, `-1500`)
+		test(1500*time.Millisecond, `1.5`)
+		test(30*time.Second, `30`)
+		test(30*time.Second, `30.0`)
+
+		var val gt.NullDuration
+		fail(val.Parse(`P1Y`))
+		fail(val.Parse(`P1M`))
+		fail(val.Parse(`1 year 02:00:00`))
+		fail(val.Parse(`2 mons`))
+		fail(val.Parse(`1 week`))
+		fail(val.Parse(`02:00:00 1 day`))
+		fail(val.Parse(`1:x:00`))
+		fail(val.Parse(`1h30`))
+		fail(val.Parse(`NaN`))
+		fail(val.Parse(`1e300`))
+		fail(val.Parse(`99999999999999999999`))
+		fail(val.Parse(`9223372037`))
+		fail(val.Parse(`106751992 days`))
+		fail(val.Parse(`106751 days 23:47:17`))
+		fail(val.Parse(`2562048:00:00`))
+		fail(val.Parse(`9999999999999999:00`))
+		fail(val.Parse(`00:9999999999999999`))
+		fail(val.Parse(`99999999999 days`))
+		eq(gt.NullDuration(0), val)
+
+		test(9223372036*time.Second, `9223372036`)
+		test(106751*24*time.Hour+23*time.Hour+47*time.Minute+16*time.Second, `106751 days 23:47:16`)
+	})
+
+	t.Run(`UnmarshalJSON`, func(t *testing.T) {
+		test := func(exp time.Duration, src string) {
+			t.Helper()
+			var val gt.NullDuration
+			try(val.UnmarshalJSON([]byte(src)))
+			eq(gt.NullDuration(exp), val)
+		}
+
+		test(0, `null`)
+		test(time.Hour, `"1h"`)
+		test(time.Hour, `"PT1H"`)
+		test(1500*time.Second, `1500`)
+		test(30*time.Second, `30`)
+		test(30*time.Second, `30.0`)
+		test(1500*time.Millisecond, `1.5`)
+		test(-1500*time.Millisecond, `-1.5`)
+
+		var val gt.NullDuration
+		fail(val.UnmarshalJSON([]byte(`true`)))
+		fail(val.UnmarshalJSON([]byte(`{}`)))
+	})
+
+	t.Run(`Scan`, func(t *testing.T) {
+		test := func(exp time.Duration, src any) {
+			t.Helper()
+			var val gt.NullDuration
+			try(val.Scan(src))
+			eq(gt.NullDuration(exp), val)
+		}
+
+		test(0, nil)
+		test(time.Hour, `01:00:00`)
+		test(time.Hour, []byte(`01:00:00`))
+		test(time.Hour, int64(time.Hour))
+		test(1500*time.Millisecond, 1.5)
+		test(time.Hour, time.Hour)
+		test(time.Hour, gt.NullDuration(time.Hour))
+		test(25*time.Hour, gt.IntervalFrom(0, 0, 1, 1, 0, 0))
+		test(25*time.Hour, gt.NullIntervalFrom(0, 0, 1, 1, 0, 0))
+		test(time.Hour, gt.NullInt(time.Hour))
+		test(time.Hour, `3600000000000`)
+		test(-time.Millisecond, []byte(`-1000000`))
+
+		// Drivers may return `bigint` as text.
+		for _, src := range []time.Duration{time.Millisecond, 90 * time.Second, -time.Hour} {
+			val, err := gt.NullDuration(src).Value()
+			try(err)
+			test(src, []byte(strconv.FormatInt(val.(int64), 10)))
+		}
+
+		var val gt.NullDuration
+		fail(val.Scan(gt.DateInterval(0, 1, 0)))
+		fail(val.Scan(`P1Y`))
+		fail(val.Scan(true))
+		fail(val.Scan(gt.IntervalFrom(0, 0, 106752, 0, 0, 0)))
+		fail(val.Scan(`9223372036854775808`))
+	})
+
+	t.Run(`Interval`, func(t *testing.T) {
+		eq(gt.Interval{}, gt.NullDuration(0).Interval())
+		eq(gt.NullInterval{}, gt.NullDuration(0).NullInterval())
+		eq(gt.TimeInterval(26, 3, 4), gt.NullDuration(26*time.Hour+3*time.Minute+4500*time.Millisecond).Interval())
+		eq(gt.TimeNullInterval(1, 30, 0), gt.NullDuration(90*time.Minute).NullInterval())
+		eq(90*time.Minute, gt.NullDuration(90*time.Minute).Duration())
+	})
+}
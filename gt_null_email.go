@@ -0,0 +1,349 @@
+package gt
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"strings"
+	"unicode/utf8"
+)
+
+// Maximum length of the local part of an email address, in bytes.
+const EmailLocalLen = 64
+
+// Maximum length of an email address, in bytes.
+const EmailLen = 254
+
+/*
+Shortcut: parses successfully or panics. Should be used only in root scope. When
+error handling is relevant, use `.Parse`.
+*/
+func ParseNullEmail(src string) (val NullEmail) {
+	try(val.Parse(src))
+	return
+}
+
+/*
+Variant of `gt.NullString` which must be a syntactically valid email address.
+Zero value is considered empty in text, and null in JSON and SQL. Non-empty
+values are validated by `.Parse`, `.Scan`, `.UnmarshalText` and
+`.UnmarshalJSON`, and are otherwise stored and encoded as-is, exactly like
+`gt.NullString`.
+
+Validation follows the RFC 5322 "addr-spec" syntax, without obsolete forms,
+comments or folding whitespace:
+
+  - The local part before the last "@" is either a dot-atom such as
+    "first.last+tag", or a quoted string such as "\"john doe\"".
+  - The domain is either a dot-atom such as "example.com", or a domain literal
+    such as "[192.168.0.1]".
+  - Non-ASCII UTF-8 characters are allowed in dot-atoms, as in RFC 6532.
+  - The local part is limited to 64 bytes, and the whole address to 254 bytes.
+
+Validation doesn't check whether the domain exists or accepts mail. The local
+part is case-sensitive; for comparisons, use `.Normalize`, which converts only
+the domain.
+*/
+type NullEmail string
+
+var (
+	_ = Encodable(NullEmail(``))
+	_ = Decodable((*NullEmail)(nil))
+)
+
+// Implement `gt.Zeroable`. True if empty.
+func (self NullEmail) IsZero() bool { return self == `` }
+
+// Implement `gt.Nullable`. True if empty.
+func (self NullEmail) IsNull() bool { return self.IsZero() }
+
+// Implement `gt.Getter`. If zero, returns `nil`, otherwise returns `string`.
+func (self NullEmail) Get() any {
+	if self.IsNull() {
+		return nil
+	}
+	return string(self)
+}
+
+// Implement `gt.Setter`, using `.Scan`. Panics on error.
+func (self *NullEmail) Set(src any) { try(self.Scan(src)) }
+
+// Implement `gt.Zeroer`, zeroing the receiver.
+func (self *NullEmail) Zero() {
+	if self != nil {
+		*self = ``
+	}
+}
+
+// Implement `fmt.Stringer`, returning the string as-is.
+func (self NullEmail) String() string {
+	return string(self)
+}
+
+/*
+Implement `gt.Parser`. If the input is empty, zeroes the receiver. Otherwise
+validates the input as described in the type's description, and assigns it
+as-is.
+*/
+func (self *NullEmail) Parse(src string) (err error) {
+	if len(src) <= 0 {
+		self.Zero()
+		return nil
+	}
+
+	defer errParse(&err, src, `email address`)
+
+	err = emailValidate(src)
+	if err != nil {
+		return err
+	}
+
+	*self = NullEmail(src)
+	return nil
+}
+
+// Implement `gt.AppenderTo`, appending the string as-is.
+func (self NullEmail) AppendTo(buf []byte) []byte {
+	return append(buf, self...)
+}
+
+/*
+Implement `encoding.TextMarhaler`. If zero, returns nil. Otherwise returns the
+string as-is.
+*/
+func (self NullEmail) MarshalText() ([]byte, error) {
+	if self.IsNull() {
+		return nil, nil
+	}
+	return self.AppendTo(nil), nil
+}
+
+// Implement `encoding.TextUnmarshaler`, using the same algorithm as `.Parse`.
+func (self *NullEmail) UnmarshalText(src []byte) error {
+	// Not using `bytesString` because the string is stored as-is, and streaming
+	// decoders tend to reuse one buffer for different content.
+	return self.Parse(string(src))
+}
+
+/*
+Implement `json.Marshaler`. If zero, returns bytes representing `null`.
+Otherwise returns bytes representing a JSON string with the same text as in
+`.String`.
+*/
+func (self NullEmail) MarshalJSON() ([]byte, error) {
+	if self.IsNull() {
+		return bytesNull, nil
+	}
+	// Both quoted local parts and domain literals may contain characters which
+	// require escaping.
+	return jsonAppendString(make([]byte, 0, len(self)+2), string(self)), nil
+}
+
+/*
+Implement `json.Unmarshaler`. If the input is empty or represents JSON `null`,
+zeroes the receiver. Otherwise decodes a JSON string and validates it, using
+the same algorithm as `.Parse`.
+*/
+func (self *NullEmail) UnmarshalJSON(src []byte) error {
+	if isJsonEmpty(src) {
+		self.Zero()
+		return nil
+	}
+	if !isJsonStr(src) {
+		return errJsonString(src, self)
+	}
+
+	var val string
+	err := json.Unmarshal(src, &val)
+	if err != nil {
+		return err
+	}
+	return self.Parse(val)
+}
+
+// Implement `driver.Valuer`, using `.Get`.
+func (self NullEmail) Value() (driver.Value, error) { return self.Get(), nil }
+
+/*
+Implement `sql.Scanner`, converting an arbitrary input to `gt.NullEmail` and
+modifying the receiver. Acceptable inputs:
+
+  - `nil`           -> use `.Zero`
+  - `string`        -> use `.Parse`
+  - `[]byte`        -> use `.UnmarshalText`
+  - `gt.NullEmail`  -> assign
+  - `gt.NullString` -> use `.Parse`
+  - `gt.Getter`     -> scan underlying value
+*/
+func (self *NullEmail) Scan(src any) error {
+	switch src := src.(type) {
+	case nil:
+		self.Zero()
+		return nil
+
+	case string:
+		return self.Parse(src)
+
+	case []byte:
+		return self.UnmarshalText(src)
+
+	case NullEmail:
+		*self = src
+		return nil
+
+	case NullString:
+		return self.Parse(string(src))
+
+	default:
+		val, ok := get(src)
+		if ok {
+			return self.Scan(val)
+		}
+		return errScanType(self, src)
+	}
+}
+
+// Same as `len(self)`.
+func (self NullEmail) Len() int { return len(self) }
+
+// Returns the local part: everything before the last "@". If zero, returns "".
+func (self NullEmail) Local() string {
+	ind := strings.LastIndexByte(string(self), '@')
+	if ind < 0 {
+		return ``
+	}
+	return string(self[:ind])
+}
+
+// Returns the domain: everything after the last "@". If zero, returns "".
+func (self NullEmail) Domain() string {
+	ind := strings.LastIndexByte(string(self), '@')
+	if ind < 0 {
+		return ``
+	}
+	return string(self[ind+1:])
+}
+
+/*
+Returns a normalized version of the address, suitable for comparison and
+deduplication. The local part is preserved as-is, because it's case-sensitive
+per RFC 5321. The domain is lowercased, and non-ASCII labels are converted to
+their ASCII form via Punycode, as in IDNA: "User@Bücher.Example" becomes
+"User@xn--bcher-kva.example". Domain literals such as "[192.168.0.1]" are
+preserved. Unicode normalization and UTS 46 mapping are not performed. If zero,
+returns zero.
+*/
+func (self NullEmail) Normalize() NullEmail {
+	if self.IsNull() {
+		return self
+	}
+
+	domain := self.Domain()
+	if strings.HasPrefix(domain, `[`) {
+		return self
+	}
+	return NullEmail(self.Local() + `@` + idnaToAscii(domain))
+}
+
+func emailValidate(src string) error {
+	ind := strings.LastIndexByte(src, '@')
+	if ind < 0 {
+		return errEmailAt
+	}
+
+	local, domain := src[:ind], src[ind+1:]
+	if len(local) > EmailLocalLen || len(src) > EmailLen {
+		return errEmailLength
+	}
+	if !utf8.ValidString(src) {
+		return errInvalidChar
+	}
+
+	if strings.HasPrefix(local, `"`) {
+		if !emailValidQuoted(local) {
+			return errEmailLocal
+		}
+	} else if !emailValidDotAtom(local) {
+		return errEmailLocal
+	}
+
+	if strings.HasPrefix(domain, `[`) {
+		if !emailValidLiteral(domain) {
+			return errEmailDomain
+		}
+	} else if !emailValidDotAtom(domain) {
+		return errEmailDomain
+	}
+	return nil
+}
+
+/*
+RFC 5322 "dot-atom": non-empty runs of "atext" separated by single dots, with
+UTF-8 allowed as in RFC 6532.
+*/
+func emailValidDotAtom(src string) bool {
+	if len(src) <= 0 || src[0] == '.' || src[len(src)-1] == '.' {
+		return false
+	}
+	for ind := 0; ind < len(src); ind++ {
+		char := src[ind]
+		if char == '.' {
+			if src[ind-1] == '.' {
+				return false
+			}
+			continue
+		}
+		if !isEmailAtext(char) {
+			return false
+		}
+	}
+	return true
+}
+
+// RFC 5322 "quoted-string" without folding whitespace, with UTF-8 allowed.
+func emailValidQuoted(src string) bool {
+	if len(src) < 2 || src[0] != '"' || src[len(src)-1] != '"' {
+		return false
+	}
+
+	end := len(src) - 1
+	for ind := 1; ind < end; ind++ {
+		char := src[ind]
+		if char == '\\' {
+			ind++
+			if ind >= end || !isEmailQuotable(src[ind]) {
+				return false
+			}
+			continue
+		}
+		if char == '"' || !isEmailQuotable(char) {
+			return false
+		}
+	}
+	return true
+}
+
+// RFC 5322 "domain-literal" without folding whitespace.
+func emailValidLiteral(src string) bool {
+	if len(src) < 2 || src[0] != '[' || src[len(src)-1] != ']' {
+		return false
+	}
+	for _, char := range []byte(src[1 : len(src)-1]) {
+		if char < 33 || char > 126 || char == '[' || char == ']' || char == '\\' {
+			return false
+		}
+	}
+	return true
+}
+
+func isEmailAtext(char byte) bool {
+	return (char >= 'a' && char <= 'z') ||
+		(char >= 'A' && char <= 'Z') ||
+		(char >= '0' && char <= '9') ||
+		char >= utf8.RuneSelf ||
+		strings.IndexByte("!#$%&'*+-/=?^_`{|}~", char) >= 0
+}
+
+// Printable characters, space and tab, which may occur in quoted strings.
+func isEmailQuotable(char byte) bool {
+	return char == ' ' || char == '\t' || (char > ' ' && char != 0x7f)
+}
@@ -0,0 +1,143 @@
+package gt_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/mitranim/gt"
+)
+
+func TestNullEmail_common(t *testing.T) {
+	var (
+		primZero    = any(nil)
+		primNonZero = `user@example.com`
+		textZero    = ``
+		textNonZero = `user@example.com`
+		jsonZero    = bytesNull
+		jsonNonZero = []byte(`"user@example.com"`)
+		zero        = gt.NullEmail(``)
+		nonZero     = gt.NullEmail(`user@example.com`)
+		dec         = new(gt.NullEmail)
+	)
+
+	eq(true, zero.IsNull())
+	eq(false, nonZero.IsNull())
+
+	testAny(t, primZero, primNonZero, textZero, textNonZero, jsonZero, jsonNonZero, zero, nonZero, dec)
+}
+
+func TestNullEmail(t *testing.T) {
+	t.Run(`Parse`, func(t *testing.T) {
+		test := func(src string) {
+			t.Helper()
+			eq(src, gt.ParseNullEmail(src).String())
+		}
+
+		test(``)
+		test(`user@example.com`)
+		test(`first.last+tag@sub.example.com`)
+		test(`user@localhost`)
+		test("!#$%&'*+-/=?^_`{|}~@example.com")
+		test(`"john doe"@example.com`)
+		test(`"john@doe"@example.com`)
+		test(`"quote\"back\\slash"@example.com`)
+		test(`""@example.com`)
+		test(`user@[192.168.0.1]`)
+		test(`user@[IPv6:2001:db8::1]`)
+		test(`пользователь@пример.рф`)
+		test(`user@bücher.example`)
+		test(strings.Repeat(`a`, 64) + `@example.com`)
+
+		var val gt.NullEmail
+		fail(val.Parse(`user`))
+		fail(val.Parse(`@example.com`))
+		fail(val.Parse(`user@`))
+		fail(val.Parse(`user@@example.com`))
+		fail(val.Parse(`.user@example.com`))
+		fail(val.Parse(`user.@example.com`))
+		fail(val.Parse(`first..last@example.com`))
+		fail(val.Parse(`user@example..com`))
+		fail(val.Parse(`user@.example.com`))
+		fail(val.Parse(`user@example.com.`))
+		fail(val.Parse(`john doe@example.com`))
+		fail(val.Parse(` user@example.com`))
+		fail(val.Parse(`user@example.com `))
+		fail(val.Parse(`user@exa mple.com`))
+		fail(val.Parse(`"unclosed@example.com`))
+		fail(val.Parse(`"a"b@example.com`))
+		fail(val.Parse(`"trailing\"@example.com`))
+		fail(val.Parse("\"new\nline\"@example.com"))
+		fail(val.Parse(`user@[1.2.3.4`))
+		fail(val.Parse(`user@[1.2[3].4]`))
+		fail(val.Parse("user@exa\xffmple.com"))
+		fail(val.Parse(strings.Repeat(`a`, 65) + `@example.com`))
+		fail(val.Parse(`user@` + strings.Repeat(`a`, 250)))
+		eq(gt.NullEmail(``), val)
+	})
+
+	t.Run(`parts`, func(t *testing.T) {
+		val := gt.ParseNullEmail(`"john@doe"@example.com`)
+		eq(`"john@doe"`, val.Local())
+		eq(`example.com`, val.Domain())
+		eq(22, val.Len())
+
+		eq(``, gt.NullEmail(``).Local())
+		eq(``, gt.NullEmail(``).Domain())
+	})
+
+	t.Run(`Normalize`, func(t *testing.T) {
+		test := func(exp, src string) {
+			t.Helper()
+			eq(gt.NullEmail(exp), gt.ParseNullEmail(src).Normalize())
+		}
+
+		test(``, ``)
+		test(`User@example.com`, `User@Example.COM`)
+		test(`User@xn--bcher-kva.example`, `User@Bücher.Example`)
+		test(`user@xn--mnchen-3ya.de`, `user@münchen.de`)
+		test(`user@xn--e1afmkfd.xn--80akhbyknj4f`, `user@пример.испытание`)
+		test(`user@xn--fsqu00a.xn--0zwm56d`, `user@例子.测试`)
+		test(`user@[IPv6:2001:DB8::1]`, `user@[IPv6:2001:DB8::1]`)
+	})
+
+	t.Run(`JSON`, func(t *testing.T) {
+		eq(`"\"quote\\\"d\"@example.com"`, string(tryByteSlice(gt.ParseNullEmail(`"quote\"d"@example.com`).MarshalJSON())))
+		eq(`"user@example.com"`, string(tryByteSlice(gt.ParseNullEmail(`user@example.com`).MarshalJSON())))
+
+		src := gt.ParseNullEmail(`a@[1"2]`)
+		out := tryByteSlice(src.MarshalJSON())
+		eq(`"a@[1\"2]"`, string(out))
+
+		var dec gt.NullEmail
+		try(dec.UnmarshalJSON(out))
+		eq(src, dec)
+
+		var val gt.NullEmail
+		try(val.UnmarshalJSON([]byte(`"\"quote\\\"d\"@example.com"`)))
+		eq(gt.NullEmail(`"quote\"d"@example.com`), val)
+
+		fail(val.UnmarshalJSON([]byte(`"user"`)))
+		fail(val.UnmarshalJSON([]byte(`123`)))
+	})
+
+	t.Run(`Scan`, func(t *testing.T) {
+		test := func(exp string, src any) {
+			t.Helper()
+			var val gt.NullEmail
+			try(val.Scan(src))
+			eq(gt.NullEmail(exp), val)
+		}
+
+		test(``, nil)
+		test(`user@example.com`, `user@example.com`)
+		test(`user@example.com`, []byte(`user@example.com`))
+		test(`user@example.com`, gt.NullString(`user@example.com`))
+		test(`user@example.com`, gt.NullEmail(`user@example.com`))
+
+		var val gt.NullEmail
+		fail(val.Scan(`user`))
+		fail(val.Scan([]byte(`user@`)))
+		fail(val.Scan(gt.NullString(`@example.com`)))
+		fail(val.Scan(int64(1)))
+	})
+}
@@ -0,0 +1,202 @@
+package gt
+
+import (
+	"database/sql/driver"
+)
+
+/*
+Shortcut: converts the value to `gt.NullEnum`, panicking if the value is
+non-zero and not listed in `T.EnumValues`. Should be used only in root scope.
+When error handling is relevant, use `gt.NullEnum.Scan`.
+*/
+func NullEnumOf[T Enumer[T]](val T) (out NullEnum[T]) {
+	try(out.Scan(val))
+	return
+}
+
+/*
+Shortcut: parses successfully or panics. Should be used only in root scope. When
+error handling is relevant, use `.Parse`.
+*/
+func ParseNullEnum[T Enumer[T]](src string) (val NullEnum[T]) {
+	try(val.Parse(src))
+	return
+}
+
+/*
+Variant of `gt.Enum` where zero value is considered empty in text, and null in
+JSON and SQL. Non-zero values are validated against `T.EnumValues` when
+decoding, exactly like `gt.Enum`. Because the zero value of `T` represents null,
+it should not be listed in `T.EnumValues`.
+*/
+type NullEnum[T Enumer[T]] struct{ val T }
+
+var (
+	_ = Encodable(NullEnum[enumExample]{})
+	_ = Decodable((*NullEnum[enumExample])(nil))
+	_ = EnumLister(NullEnum[enumExample]{})
+)
+
+// Implement `gt.Zeroable`. True if the underlying value is the zero value of `T`.
+func (self NullEnum[T]) IsZero() bool { return Enum[T](self).IsZero() }
+
+// Implement `gt.Nullable`. True if zero.
+func (self NullEnum[T]) IsNull() bool { return self.IsZero() }
+
+/*
+Implement `gt.Getter`. If zero, returns `nil`, otherwise behaves like
+`gt.Enum.Get`.
+*/
+func (self NullEnum[T]) Get() any {
+	if self.IsNull() {
+		return nil
+	}
+	return enumGet(self.val)
+}
+
+// Implement `gt.Setter`, using `.Scan`. Panics on error.
+func (self *NullEnum[T]) Set(src any) { try(self.Scan(src)) }
+
+// Implement `gt.Zeroer`, zeroing the receiver.
+func (self *NullEnum[T]) Zero() {
+	if self != nil {
+		*self = NullEnum[T]{}
+	}
+}
+
+/*
+Implement `fmt.Stringer`. If zero, returns an empty string. Otherwise behaves
+like `gt.Enum.String`.
+*/
+func (self NullEnum[T]) String() string {
+	if self.IsNull() {
+		return ``
+	}
+	return enumString(self.val)
+}
+
+/*
+Implement `gt.Parser`. If the input is empty, zeroes the receiver. Otherwise
+behaves like `gt.Enum.Parse`.
+*/
+func (self *NullEnum[T]) Parse(src string) error {
+	if len(src) <= 0 {
+		self.Zero()
+		return nil
+	}
+	return (*Enum[T])(self).Parse(src)
+}
+
+// Implement `gt.AppenderTo`, using the same representation as `.String`.
+func (self NullEnum[T]) AppendTo(buf []byte) []byte {
+	if self.IsNull() {
+		return buf
+	}
+	return enumAppend(buf, self.val)
+}
+
+/*
+Implement `encoding.TextMarhaler`. If zero, returns nil. Otherwise returns the
+same representation as `.String`.
+*/
+func (self NullEnum[T]) MarshalText() ([]byte, error) {
+	if self.IsNull() {
+		return nil, nil
+	}
+	return self.AppendTo(nil), nil
+}
+
+// Implement `encoding.TextUnmarshaler`, using the same algorithm as `.Parse`.
+func (self *NullEnum[T]) UnmarshalText(src []byte) error {
+	return self.Parse(bytesString(src))
+}
+
+/*
+Implement `json.Marshaler`. If zero, returns bytes representing `null`.
+Otherwise behaves like `gt.Enum.MarshalJSON`.
+*/
+func (self NullEnum[T]) MarshalJSON() ([]byte, error) {
+	if self.IsNull() {
+		return bytesNull, nil
+	}
+	return enumAppendJson(nil, self.val), nil
+}
+
+/*
+Implement `json.Unmarshaler`. If the input is empty or represents JSON `null`,
+zeroes the receiver. Otherwise behaves like `gt.Enum.UnmarshalJSON`.
+*/
+func (self *NullEnum[T]) UnmarshalJSON(src []byte) error {
+	if isJsonEmpty(src) {
+		self.Zero()
+		return nil
+	}
+	return (*Enum[T])(self).UnmarshalJSON(src)
+}
+
+// Implement `driver.Valuer`, using `.Get`.
+func (self NullEnum[T]) Value() (driver.Value, error) {
+	return self.Get(), nil
+}
+
+/*
+Implement `sql.Scanner`, converting an arbitrary input to `gt.NullEnum` and
+modifying the receiver. Acceptable inputs:
+
+  - `nil`            -> use `.Zero`
+  - `string`         -> use `.Parse`
+  - `[]byte`         -> use `.UnmarshalText`
+  - `intN`, `uintN`  -> validate and convert, only for integer-backed enums
+  - `T`              -> use `.Zero` if zero, otherwise validate and assign
+  - `gt.Enum[T]`     -> assign
+  - `gt.NullEnum[T]` -> assign
+  - `gt.Getter`      -> scan underlying value
+*/
+func (self *NullEnum[T]) Scan(src any) error {
+	switch src := src.(type) {
+	case nil:
+		self.Zero()
+		return nil
+
+	case string:
+		return self.Parse(src)
+
+	case []byte:
+		return self.UnmarshalText(src)
+
+	case T:
+		var zero T
+		if src == zero {
+			self.Zero()
+			return nil
+		}
+		return (*Enum[T])(self).Scan(src)
+
+	case NullEnum[T]:
+		*self = src
+		return nil
+
+	default:
+		val, ok := get(src)
+		if ok && val == nil {
+			self.Zero()
+			return nil
+		}
+		return (*Enum[T])(self).Scan(src)
+	}
+}
+
+// Returns the underlying value.
+func (self NullEnum[T]) Val() T { return self.val }
+
+// True if zero or listed in `T.EnumValues`.
+func (self NullEnum[T]) IsValid() bool { return self.IsNull() || enumValid(self.val) }
+
+// Returns the allowed values, as listed by `T.EnumValues`.
+func (self NullEnum[T]) Values() []T { return enumValues[T]() }
+
+// Implement `gt.EnumLister`.
+func (self NullEnum[T]) EnumList() []any { return enumList[T]() }
+
+// Converts to `gt.Enum`.
+func (self NullEnum[T]) Enum() Enum[T] { return Enum[T](self) }
@@ -0,0 +1,182 @@
+package gt
+
+import "database/sql/driver"
+
+/*
+Shortcut: combines the given flags into `gt.NullFlags`, panicking if any of
+them has unnamed bits. Should be used only in root scope. When error handling
+is relevant, use `gt.NullFlags.Scan`.
+*/
+func NullFlagsOf[T Flagger](vals ...T) NullFlags[T] {
+	return NullFlags[T](FlagsOf(vals...))
+}
+
+/*
+Shortcut: parses successfully or panics. Should be used only in root scope. When
+error handling is relevant, use `.Parse`.
+*/
+func ParseNullFlags[T Flagger](src string) (val NullFlags[T]) {
+	try(val.Parse(src))
+	return
+}
+
+/*
+Variant of `gt.Flags` where zero value is considered empty in text, and null in
+JSON and SQL. Unlike `gt.Flags`, the empty set is encoded as JSON `null` rather
+than `[]`, and as SQL `null` rather than 0.
+*/
+type NullFlags[T Flagger] struct{ val T }
+
+var (
+	_ = Encodable(NullFlags[flagsExample]{})
+	_ = Decodable((*NullFlags[flagsExample])(nil))
+)
+
+// Implement `gt.Zeroable`. True if no bits are set.
+func (self NullFlags[T]) IsZero() bool { return self.val == 0 }
+
+// Implement `gt.Nullable`. True if zero.
+func (self NullFlags[T]) IsNull() bool { return self.IsZero() }
+
+// Implement `gt.Getter`. If zero, returns `nil`, otherwise returns `uint64`.
+func (self NullFlags[T]) Get() any {
+	if self.IsNull() {
+		return nil
+	}
+	return uint64(self.val)
+}
+
+// Implement `gt.Setter`, using `.Scan`. Panics on error.
+func (self *NullFlags[T]) Set(src any) { try(self.Scan(src)) }
+
+// Implement `gt.Zeroer`, zeroing the receiver.
+func (self *NullFlags[T]) Zero() {
+	if self != nil {
+		*self = NullFlags[T]{}
+	}
+}
+
+// Implement `fmt.Stringer`. Same as `gt.Flags.String`.
+func (self NullFlags[T]) String() string { return self.Flags().String() }
+
+// Implement `gt.Parser`. Same as `gt.Flags.Parse`.
+func (self *NullFlags[T]) Parse(src string) error {
+	return (*Flags[T])(self).Parse(src)
+}
+
+// Implement `gt.AppenderTo`, using the same representation as `.String`.
+func (self NullFlags[T]) AppendTo(buf []byte) []byte {
+	return self.Flags().AppendTo(buf)
+}
+
+/*
+Implement `encoding.TextMarhaler`. If zero, returns nil. Otherwise returns the
+same representation as `.String`.
+*/
+func (self NullFlags[T]) MarshalText() ([]byte, error) {
+	if self.IsNull() {
+		return nil, nil
+	}
+	return self.AppendTo(nil), nil
+}
+
+// Implement `encoding.TextUnmarshaler`, using the same algorithm as `.Parse`.
+func (self *NullFlags[T]) UnmarshalText(src []byte) error {
+	return self.Parse(bytesString(src))
+}
+
+/*
+Implement `json.Marshaler`. If zero, returns bytes representing `null`.
+Otherwise behaves like `gt.Flags.MarshalJSON`.
+*/
+func (self NullFlags[T]) MarshalJSON() ([]byte, error) {
+	if self.IsNull() {
+		return bytesNull, nil
+	}
+	return self.Flags().MarshalJSON()
+}
+
+// Implement `json.Unmarshaler`. Same as `gt.Flags.UnmarshalJSON`.
+func (self *NullFlags[T]) UnmarshalJSON(src []byte) error {
+	return (*Flags[T])(self).UnmarshalJSON(src)
+}
+
+// Implement `driver.Valuer`, using `.Get`.
+func (self NullFlags[T]) Value() (driver.Value, error) {
+	return self.Get(), nil
+}
+
+/*
+Implement `sql.Scanner`, converting an arbitrary input to `gt.NullFlags` and
+modifying the receiver. Acceptable inputs:
+
+  - `nil`             -> use `.Zero`
+  - `gt.NullFlags[T]` -> assign
+  - `gt.Getter`       -> use `.Zero` if the underlying value is `nil`
+  - other             -> use `gt.Flags.Scan`
+*/
+func (self *NullFlags[T]) Scan(src any) error {
+	switch src := src.(type) {
+	case nil:
+		self.Zero()
+		return nil
+
+	case NullFlags[T]:
+		*self = src
+		return nil
+
+	default:
+		val, ok := get(src)
+		if ok && val == nil {
+			self.Zero()
+			return nil
+		}
+		return (*Flags[T])(self).Scan(src)
+	}
+}
+
+// Free cast to `gt.Flags`.
+func (self NullFlags[T]) Flags() Flags[T] { return Flags[T](self) }
+
+// Returns the underlying value.
+func (self NullFlags[T]) Val() T { return self.val }
+
+// Same as `gt.Flags.Has`.
+func (self NullFlags[T]) Has(flag T) bool { return self.Flags().Has(flag) }
+
+// Same as `gt.Flags.HasAny`.
+func (self NullFlags[T]) HasAny(flag T) bool { return self.Flags().HasAny(flag) }
+
+// Same as `gt.Flags.Add`.
+func (self NullFlags[T]) Add(flag T) NullFlags[T] {
+	return NullFlags[T](self.Flags().Add(flag))
+}
+
+// Same as `gt.Flags.Clear`.
+func (self NullFlags[T]) Clear(flag T) NullFlags[T] {
+	return NullFlags[T](self.Flags().Clear(flag))
+}
+
+// Same as `gt.Flags.Toggle`.
+func (self NullFlags[T]) Toggle(flag T) NullFlags[T] {
+	return NullFlags[T](self.Flags().Toggle(flag))
+}
+
+// Same as `gt.Flags.Union`.
+func (self NullFlags[T]) Union(val NullFlags[T]) NullFlags[T] {
+	return NullFlags[T](self.Flags().Union(val.Flags()))
+}
+
+// Same as `gt.Flags.Intersect`.
+func (self NullFlags[T]) Intersect(val NullFlags[T]) NullFlags[T] {
+	return NullFlags[T](self.Flags().Intersect(val.Flags()))
+}
+
+// Same as `gt.Flags.Len`.
+func (self NullFlags[T]) Len() int { return self.Flags().Len() }
+
+// Same as `gt.Flags.Names`.
+func (self NullFlags[T]) Names() []string { return self.Flags().Names() }
+
+// Same as `gt.Flags.IsValid`.
+func (self NullFlags[T]) IsValid() bool { return self.Flags().IsValid() }
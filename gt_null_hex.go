@@ -0,0 +1,148 @@
+package gt
+
+import "database/sql/driver"
+
+/*
+Variant of `gt.NullBytes` which uses lowercase hex in text and JSON, such as
+"0a1b2c". Decoding is case-insensitive. In SQL, stores and returns raw bytes
+as-is. Follows the same capacity-reusing semantics as `gt.NullBytes`.
+
+Unlike `gt.HexUint`, this has no size limit, and preserves leading zero bytes.
+*/
+type NullHex []byte
+
+var (
+	_ = Encodable(NullHex(nil))
+	_ = Decodable((*NullHex)(nil))
+)
+
+// Implement `gt.Zeroable`. Equivalent to `len(self)` <= 0.
+// NOT equivalent to `self == nil` or `reflect.ValueOf(self).IsZero()`.
+func (self NullHex) IsZero() bool { return len(self) <= 0 }
+
+// Implement `gt.Nullable`. True if empty.
+func (self NullHex) IsNull() bool { return self.IsZero() }
+
+/*
+Implement `gt.Getter`. If empty, returns `nil`, otherwise returns self as
+`[]byte`.
+*/
+func (self NullHex) Get() any {
+	if self.IsNull() {
+		return nil
+	}
+	return []byte(self)
+}
+
+// Implement `gt.Setter`, using `.Scan`. Panics on error.
+func (self *NullHex) Set(src any) { try(self.Scan(src)) }
+
+/*
+Implement `gt.Zeroer`, emptying the receiver. If the receiver was non-nil, its
+length is reduced to 0 while keeping any capacity, and it remains non-nil.
+*/
+func (self *NullHex) Zero() { bytesZero((*[]byte)(self)) }
+
+// Implement `fmt.Stringer`, returning lowercase hex.
+func (self NullHex) String() string { return bytesString(self.AppendTo(nil)) }
+
+/*
+Implement `gt.Parser`. If the input is empty, empties the receiver while keeping
+any capacity. Otherwise decodes hex in any case, reusing the receiver's
+capacity.
+*/
+func (self *NullHex) Parse(src string) error {
+	return self.UnmarshalText([]byte(src))
+}
+
+// Implement `gt.AppenderTo`, appending lowercase hex.
+func (self NullHex) AppendTo(buf []byte) []byte {
+	return bytesEncode(buf, bytesHex{}, self)
+}
+
+/*
+Implement `encoding.TextMarhaler`. If empty, returns nil. Otherwise returns the
+same representation as `.String`.
+*/
+func (self NullHex) MarshalText() ([]byte, error) {
+	if self.IsNull() {
+		return nil, nil
+	}
+	return self.AppendTo(nil), nil
+}
+
+// Implement `encoding.TextUnmarshaler`, using the same algorithm as `.Parse`.
+func (self *NullHex) UnmarshalText(src []byte) error {
+	return bytesDecode((*[]byte)(self), bytesHex{}, src, `hex`)
+}
+
+/*
+Implement `json.Marshaler`. If empty, returns bytes representing `null`.
+Otherwise returns bytes representing a JSON string with the same text as in
+`.String`.
+*/
+func (self NullHex) MarshalJSON() ([]byte, error) {
+	return bytesMarshalJson(bytesHex{}, self)
+}
+
+/*
+Implement `json.Unmarshaler`. If the input is empty or represents JSON `null`,
+empties the receiver while keeping any capacity. Otherwise parses a JSON
+string, using the same algorithm as `.Parse`.
+*/
+func (self *NullHex) UnmarshalJSON(src []byte) error {
+	if isJsonEmpty(src) {
+		self.Zero()
+		return nil
+	}
+	if isJsonStr(src) {
+		return self.UnmarshalText(cutJsonStr(src))
+	}
+	return errJsonString(src, self)
+}
+
+// Implement `driver.Valuer`, using `.Get`.
+func (self NullHex) Value() (driver.Value, error) { return self.Get(), nil }
+
+/*
+Implement `sql.Scanner`, converting an arbitrary input to `gt.NullHex` and
+modifying the receiver. Unlike most other decoding methods, `[]byte` is treated
+as raw binary data rather than text. Acceptable inputs:
+
+  - `nil`             -> use `.Zero`
+  - `[]byte`          -> copy as-is
+  - `string`          -> decode `bytea` hex format "\x..." or use `.Parse`
+  - `gt.NullHex`      -> assign
+  - `gt.NullBytes`    -> copy as-is
+  - `gt.NullBytesUrl` -> copy as-is
+  - `gt.Raw`          -> copy as-is
+  - `gt.Getter`       -> scan underlying value
+*/
+func (self *NullHex) Scan(src any) error {
+	switch src := src.(type) {
+	case string:
+		ok, err := bytesScanBytea((*[]byte)(self), src)
+		if ok {
+			return err
+		}
+		return self.Parse(src)
+
+	case NullHex:
+		*self = src
+		return nil
+
+	default:
+		ok, err := bytesScan((*[]byte)(self), src)
+		if ok {
+			return err
+		}
+		val, ok := get(src)
+		if ok {
+			return self.Scan(val)
+		}
+		return errScanType(self, src)
+	}
+}
+
+// Same as `len(self)`.
+func (self NullHex) Len() int { return len(self) }
@@ -0,0 +1,247 @@
+package gt
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	r "reflect"
+)
+
+/*
+Shortcut: parses successfully or panics. Should be used only in root scope. When
+error handling is relevant, use `.Parse`.
+*/
+func ParseNullJson(src string) (val NullJson) {
+	try(val.Parse(src))
+	return
+}
+
+/*
+Variant of `gt.Raw` which must contain valid JSON. Supports text, JSON, SQL, and
+is compatible with Postgres `json` and `jsonb`. In all contexts, stores and
+returns self as-is, like `gt.Raw`, but all decoding methods validate the input
+with `json.Valid` and reject invalid JSON. This prevents a bad database value or
+text input from producing a corrupt JSON response. Validation can be bypassed
+only by direct type conversion, such as `gt.NullJson(someBytes)`.
+
+Just like `gt.Raw`, zero value is empty and considered null; decoding reuses
+the receiver's capacity, and if the receiver had enough capacity, its backing
+array may be mutated.
+
+For normalization, see `.Compact`, `.Indent` and `.Canonical`. To compare or
+hash JSON documents regardless of formatting and key order, use `.Canonical`.
+*/
+type NullJson []byte
+
+var (
+	_ = Encodable(NullJson(nil))
+	_ = Decodable((*NullJson)(nil))
+)
+
+// Implement `gt.Zeroable`. Equivalent to `len(self)` <= 0.
+// NOT equivalent to `self == nil` or `reflect.ValueOf(self).IsZero()`.
+func (self NullJson) IsZero() bool { return len(self) <= 0 }
+
+// Implement `gt.Nullable`. True if empty.
+func (self NullJson) IsNull() bool { return self.IsZero() }
+
+/*
+Implement `gt.Getter`. If empty, returns `nil`, otherwise returns self as
+`[]byte`.
+*/
+func (self NullJson) Get() any {
+	if self.IsNull() {
+		return nil
+	}
+	return []byte(self)
+}
+
+// Implement `gt.Setter`, using `.Scan`. Panics on error.
+func (self *NullJson) Set(src any) { try(self.Scan(src)) }
+
+/*
+Implement `gt.Zeroer`, emptying the receiver. If the receiver was non-nil, its
+length is reduced to 0 while keeping any capacity, and it remains non-nil.
+*/
+func (self *NullJson) Zero() { bytesZero((*[]byte)(self)) }
+
+// Implement `fmt.Stringer`. Returns self as-is, performing an unsafe cast.
+func (self NullJson) String() string { return bytesString(self) }
+
+/*
+Implement `gt.Parser`. If the input is empty, empties the receiver while keeping
+any capacity. Otherwise validates the input and stores it as-is, copying it for
+safety. If the receiver had enough capacity, its backing array may be mutated
+by this.
+*/
+func (self *NullJson) Parse(src string) error {
+	return self.UnmarshalText([]byte(src))
+}
+
+// Implement `gt.AppenderTo`, appending self to the buffer as-is.
+func (self NullJson) AppendTo(buf []byte) []byte { return append(buf, self...) }
+
+/*
+Implement `encoding.TextMarhaler`. If empty, returns nil. Otherwise returns self
+as-is.
+*/
+func (self NullJson) MarshalText() ([]byte, error) {
+	if self.IsNull() {
+		return nil, nil
+	}
+	return self, nil
+}
+
+/*
+Implement `encoding.TextUnmarshaler`. If the input is empty, empties the
+receiver while keeping any capacity. Otherwise validates the input and stores
+it as-is, copying it for safety. If the receiver had enough capacity, its
+backing array may be mutated by this.
+*/
+func (self *NullJson) UnmarshalText(src []byte) error {
+	if len(src) <= 0 {
+		self.Zero()
+		return nil
+	}
+	if !json.Valid(src) {
+		return errInvalidJson(src)
+	}
+	*self = append((*self)[:0], src...)
+	return nil
+}
+
+/*
+Implement `json.Marshaler`. If empty, returns bytes representing `null`.
+Otherwise returns self as-is.
+*/
+func (self NullJson) MarshalJSON() ([]byte, error) {
+	if self.IsNull() {
+		return bytesNull, nil
+	}
+	return self, nil
+}
+
+/*
+Implement `json.Unmarshaler`. If the input is empty or represents JSON `null`,
+empties the receiver while keeping any capacity. Otherwise validates the input
+and stores it as-is, copying it for safety. If the receiver had enough
+capacity, its backing array may be mutated by this.
+*/
+func (self *NullJson) UnmarshalJSON(src []byte) error {
+	if isJsonEmpty(src) {
+		self.Zero()
+		return nil
+	}
+	return self.UnmarshalText(src)
+}
+
+// Implement `driver.Valuer`, using `.Get`.
+func (self NullJson) Value() (driver.Value, error) { return self.Get(), nil }
+
+/*
+Implement `sql.Scanner`, converting an arbitrary input to `gt.NullJson` and
+modifying the receiver. All inputs other than `gt.NullJson` are validated.
+Acceptable inputs:
+
+  - `nil`                   -> use `.Zero`
+  - `string`                -> use `.Parse`
+  - `[]byte`                -> use `.UnmarshalText`
+  - convertible to `string` -> use `.Parse`
+  - convertible to `[]byte` -> use `.UnmarshalText`
+  - `gt.NullJson`           -> assign, replacing the receiver
+  - `gt.Getter`             -> scan underlying value
+*/
+func (self *NullJson) Scan(src any) error {
+	switch src := src.(type) {
+	case nil:
+		self.Zero()
+		return nil
+
+	case string:
+		return self.Parse(src)
+
+	case []byte:
+		return self.UnmarshalText(src)
+
+	case NullJson:
+		*self = src
+		return nil
+
+	default:
+		val := r.ValueOf(src)
+		if val.Kind() == r.String {
+			return self.Parse(val.String())
+		}
+		if val.Kind() == r.Slice && val.Type().Elem().Kind() == r.Uint8 {
+			return self.UnmarshalText(val.Bytes())
+		}
+
+		got, ok := get(src)
+		if ok {
+			return self.Scan(got)
+		}
+		return errScanType(self, src)
+	}
+}
+
+// Same as `len(self)`.
+func (self NullJson) Len() int { return len(self) }
+
+// Free cast to `gt.Raw`.
+func (self NullJson) Raw() Raw { return Raw(self) }
+
+/*
+True if the content is valid JSON. Always true for values obtained via decoding
+methods. False if empty.
+*/
+func (self NullJson) IsValid() bool { return json.Valid(self) }
+
+/*
+Returns a new copy with insignificant whitespace removed, using `json.Compact`.
+If empty, returns nil. Panics if the content is not valid JSON, which is only
+possible when bypassing validation via type conversion.
+*/
+func (self NullJson) Compact() NullJson {
+	if self.IsNull() {
+		return nil
+	}
+
+	var buf bytes.Buffer
+	buf.Grow(len(self))
+	try(json.Compact(&buf, self))
+	return buf.Bytes()
+}
+
+/*
+Returns a new copy indented with the given prefix and indent, using
+`json.Indent`. If empty, returns nil. Panics if the content is not valid JSON,
+which is only possible when bypassing validation via type conversion.
+*/
+func (self NullJson) Indent(prefix, indent string) NullJson {
+	if self.IsNull() {
+		return nil
+	}
+
+	var buf bytes.Buffer
+	buf.Grow(len(self) * 2)
+	try(json.Indent(&buf, self, prefix, indent))
+	return buf.Bytes()
+}
+
+/*
+Returns a new copy in the canonical form defined by the JSON Canonicalization
+Scheme (RFC 8785). Two documents with the same data, regardless of whitespace,
+key order, string escapes or number notation, produce byte-identical output,
+which is suitable for hashing and comparison. If empty, returns nil.
+
+As required by RFC 8785, returns an error if the document has duplicate object
+keys, or numbers which can't be represented as IEEE 754 doubles, such as
+`1e400`. Numbers are formatted like in JavaScript; integers beyond 2^53 lose
+precision.
+*/
+func (self NullJson) Canonical() (NullJson, error) {
+	if self.IsNull() {
+		return nil, nil
+	}
+	return jsonCanonical(self)
+}
@@ -0,0 +1,176 @@
+package gt
+
+import "database/sql/driver"
+
+/*
+Shortcut: parses successfully or panics. Should be used only in root scope. When
+error handling is relevant, use `.Parse`.
+*/
+func ParseNullJsonOf[T any](src string) (val NullJsonOf[T]) {
+	try(val.Parse(src))
+	return
+}
+
+/*
+Variant of `gt.JsonOf` where the zero value of `T` is considered empty in text,
+and null in JSON and SQL. Non-zero values are encoded and decoded exactly like
+in `gt.JsonOf`.
+*/
+type NullJsonOf[T any] struct{ Val T }
+
+var (
+	_ = Encodable(NullJsonOf[any]{})
+	_ = Decodable((*NullJsonOf[any])(nil))
+)
+
+// Implement `gt.Zeroable`. True if the underlying value is the zero value of `T`.
+func (self NullJsonOf[T]) IsZero() bool { return jsonOfIsZero(&self.Val) }
+
+// Implement `gt.Nullable`. True if zero.
+func (self NullJsonOf[T]) IsNull() bool { return self.IsZero() }
+
+/*
+Implement `gt.Getter`. If zero, returns `nil`, otherwise returns the underlying
+value of `T` as-is. Unlike most types in this package, `.Value` doesn't use
+`.Get`, and encodes the value as JSON instead.
+*/
+func (self NullJsonOf[T]) Get() any {
+	if self.IsNull() {
+		return nil
+	}
+	return self.Val
+}
+
+// Implement `gt.PtrGetter`, returning `*T`.
+func (self *NullJsonOf[T]) GetPtr() any { return &self.Val }
+
+// Implement `gt.Setter`, using `.Scan`. Panics on error.
+func (self *NullJsonOf[T]) Set(src any) { try(self.Scan(src)) }
+
+// Implement `gt.Zeroer`, zeroing the receiver.
+func (self *NullJsonOf[T]) Zero() {
+	if self != nil {
+		*self = NullJsonOf[T]{}
+	}
+}
+
+/*
+Implement `fmt.Stringer`. If zero, returns an empty string. Otherwise returns
+the JSON representation of the underlying value. Panics if the value can't be
+encoded.
+*/
+func (self NullJsonOf[T]) String() string { return bytesString(self.AppendTo(nil)) }
+
+/*
+Implement `gt.Parser`. If the input is empty or represents JSON `null`, zeroes
+the receiver. Otherwise decodes JSON into a zero value of `T`.
+*/
+func (self *NullJsonOf[T]) Parse(src string) error {
+	return self.UnmarshalJSON([]byte(src))
+}
+
+/*
+Implement `gt.AppenderTo`. If zero, appends nothing. Otherwise appends the JSON
+representation of the underlying value. Panics if the value can't be encoded.
+*/
+func (self NullJsonOf[T]) AppendTo(buf []byte) []byte {
+	if self.IsNull() {
+		return buf
+	}
+	return self.JsonOf().AppendTo(buf)
+}
+
+/*
+Implement `encoding.TextMarhaler`. If zero, returns nil. Otherwise returns the
+JSON representation of the underlying value.
+*/
+func (self NullJsonOf[T]) MarshalText() ([]byte, error) {
+	if self.IsNull() {
+		return nil, nil
+	}
+	return self.JsonOf().MarshalJSON()
+}
+
+// Implement `encoding.TextUnmarshaler`, using the same algorithm as `.Parse`.
+func (self *NullJsonOf[T]) UnmarshalText(src []byte) error {
+	return self.UnmarshalJSON(src)
+}
+
+/*
+Implement `json.Marshaler`. If zero, returns bytes representing `null`.
+Otherwise uses `json.Marshal` on the underlying value.
+*/
+func (self NullJsonOf[T]) MarshalJSON() ([]byte, error) {
+	if self.IsNull() {
+		return bytesNull, nil
+	}
+	return self.JsonOf().MarshalJSON()
+}
+
+/*
+Implement `json.Unmarshaler`. If the input is empty or represents JSON `null`,
+zeroes the receiver. Otherwise behaves like `gt.JsonOf.UnmarshalJSON`.
+*/
+func (self *NullJsonOf[T]) UnmarshalJSON(src []byte) error {
+	return (*JsonOf[T])(self).UnmarshalJSON(src)
+}
+
+/*
+Implement `driver.Valuer`. If zero, returns `nil`. Otherwise returns the JSON
+representation of the underlying value as `[]byte`, like `gt.Raw`.
+*/
+func (self NullJsonOf[T]) Value() (driver.Value, error) {
+	if self.IsNull() {
+		return nil, nil
+	}
+	return self.JsonOf().MarshalJSON()
+}
+
+/*
+Implement `sql.Scanner`, converting an arbitrary input to `gt.NullJsonOf` and
+modifying the receiver. Acceptable inputs:
+
+  - `nil`                   -> use `.Zero`
+  - `string`                -> use `.Parse`
+  - `[]byte`                -> use `.UnmarshalText`
+  - convertible to `string` -> use `.Parse`
+  - convertible to `[]byte` -> use `.UnmarshalText`
+  - `T`                     -> assign
+  - `gt.JsonOf[T]`          -> assign
+  - `gt.NullJsonOf[T]`      -> assign
+  - `gt.Getter`             -> scan underlying value
+
+If `T` is itself a string or byte slice, strings and byte slices are still
+decoded as JSON. If `T` is an interface such as `any`, inputs convertible to
+text, such as `gt.Raw` and `gt.NullJson`, are also decoded as JSON rather than
+assigned.
+*/
+func (self *NullJsonOf[T]) Scan(src any) error {
+	switch src := src.(type) {
+	case NullJsonOf[T]:
+		*self = src
+		return nil
+
+	case JsonOf[T]:
+		self.Val = src.Val
+		return nil
+
+	case nil, string, []byte:
+		return (*JsonOf[T])(self).Scan(src)
+
+	default:
+		ok, err := jsonOfScan(self, &self.Val, src)
+		if ok {
+			return err
+		}
+
+		val, ok := get(src)
+		if ok {
+			return self.Scan(val)
+		}
+		return errScanType(self, src)
+	}
+}
+
+// Converts to `gt.JsonOf`.
+func (self NullJsonOf[T]) JsonOf() JsonOf[T] { return JsonOf[T](self) }
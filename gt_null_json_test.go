@@ -0,0 +1,217 @@
+package gt_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/mitranim/gt"
+)
+
+func TestNullJson(t *testing.T) {
+	t.Run(`encoding`, func(t *testing.T) {
+		const text = `{"one": [10, "two"]}`
+		val := gt.NullJson(text)
+
+		eq(true, gt.NullJson(nil).IsNull())
+		eq(true, gt.NullJson{}.IsNull())
+		eq(false, val.IsNull())
+
+		eq(``, gt.NullJson(nil).String())
+		eq(text, val.String())
+
+		eq([]byte(nil), tryByteSlice(gt.NullJson(nil).MarshalText()))
+		eq([]byte(text), tryByteSlice(val.MarshalText()))
+
+		eq(nil, tryInterface(gt.NullJson(nil).Value()))
+		eq([]byte(text), tryInterface(val.Value()))
+
+		eq(bytesNull, jsonBytes(gt.NullJson(nil)))
+		eq([]byte(`{"one":[10,"two"]}`), jsonBytes(val))
+		eq(
+			[]byte(`{"val":{"one":[10,"two"]}}`),
+			jsonBytes(struct {
+				Val gt.NullJson `json:"val"`
+			}{val}),
+		)
+	})
+
+	t.Run(`decoding`, func(t *testing.T) {
+		var val gt.NullJson
+
+		try(val.Parse(`[1, 2]`))
+		eq(gt.NullJson(`[1, 2]`), val)
+
+		try(val.Parse(`null`))
+		eq(gt.NullJson(`null`), val)
+
+		try(val.Parse(``))
+		eq(true, val.IsNull())
+
+		try(val.UnmarshalText([]byte(`"str"`)))
+		eq(gt.NullJson(`"str"`), val)
+
+		try(json.Unmarshal([]byte(`{"one": true}`), &val))
+		eq(gt.NullJson(`{"one": true}`), val)
+
+		try(json.Unmarshal(bytesNull, &val))
+		eq(true, val.IsNull())
+
+		try(val.Scan(`123`))
+		eq(gt.NullJson(`123`), val)
+
+		try(val.Scan([]byte(`{}`)))
+		eq(gt.NullJson(`{}`), val)
+
+		try(val.Scan(gt.Raw(`[]`)))
+		eq(gt.NullJson(`[]`), val)
+
+		try(val.Scan(gt.NullJson(`true`)))
+		eq(gt.NullJson(`true`), val)
+
+		try(val.Scan(nil))
+		eq(true, val.IsNull())
+
+		fail(val.Scan(int64(1)))
+	})
+
+	t.Run(`invalid`, func(t *testing.T) {
+		test := func(src string) {
+			t.Helper()
+
+			var val gt.NullJson
+			fail(val.Parse(src))
+			fail(val.UnmarshalText([]byte(src)))
+			fail(val.UnmarshalJSON([]byte(src)))
+			fail(val.Scan(src))
+			fail(val.Scan([]byte(src)))
+			fail(val.Scan(gt.Raw(src)))
+			eq(true, val.IsNull())
+		}
+
+		test(`{`)
+		test(`{"one"}`)
+		test(`[1,]`)
+		test(`one`)
+		test(`'one'`)
+		test(`1 2`)
+		test(`{} {}`)
+		test(`NaN`)
+
+		panics(t, `unable to decode "{" into JSON`, func() { gt.ParseNullJson(`{`) })
+	})
+
+	t.Run(`capacity`, func(t *testing.T) {
+		buf := make(gt.NullJson, 0, 16)
+		prev := buf
+
+		try(buf.Parse(`[1]`))
+		eq(gt.NullJson(`[1]`), buf)
+		sliceShared(prev, buf)
+
+		try(buf.Scan([]byte(`[2]`)))
+		eq(gt.NullJson(`[2]`), buf)
+		sliceShared(prev, buf)
+
+		fail(buf.Parse(`[3`))
+		eq(gt.NullJson(`[2]`), buf)
+
+		try(buf.Scan(nil))
+		eq(0, len(buf))
+		eq(16, cap(buf))
+	})
+
+	t.Run(`Compact`, func(t *testing.T) {
+		eq(gt.NullJson(nil), gt.NullJson(nil).Compact())
+		eq(
+			gt.NullJson(`{"one":[10,"two three"],"four":{}}`),
+			gt.ParseNullJson(" {\n\t\"one\" : [ 10 , \"two three\" ] ,\r\n \"four\": { } } ").Compact(),
+		)
+
+		src := gt.ParseNullJson(`[1, 2]`)
+		out := src.Compact()
+		sliceNotShared(src, out)
+		eq(gt.NullJson(`[1, 2]`), src)
+	})
+
+	t.Run(`Indent`, func(t *testing.T) {
+		eq(gt.NullJson(nil), gt.NullJson(nil).Indent(``, `  `))
+		eq(
+			gt.NullJson("{\n  \"one\": [\n    10\n  ]\n}"),
+			gt.ParseNullJson(`{"one":[10]}`).Indent(``, `  `),
+		)
+		eq(
+			gt.NullJson("[\n>\t1\n>]"),
+			gt.ParseNullJson(`[1]`).Indent(`>`, "\t"),
+		)
+	})
+
+	t.Run(`Canonical`, func(t *testing.T) {
+		test := func(exp, src string) {
+			t.Helper()
+			eq(gt.NullJson(exp), tryNullJson(gt.ParseNullJson(src).Canonical()))
+		}
+
+		eq(gt.NullJson(nil), tryNullJson(gt.NullJson(nil).Canonical()))
+
+		test(`null`, ` null `)
+		test(`true`, `true`)
+		test(`[]`, `[ ]`)
+		test(`{}`, `{ }`)
+		test(`{"a":1,"b":[2,{"c":3,"d":4}]}`, `{"b": [2, {"d": 4, "c": 3}], "a": 1}`)
+
+		// Keys are sorted by UTF-16 code units, not by bytes or code points.
+		test(`{"\r":1,"1":2,"A":3,"a":4,"€":5,"😀":6,"ﬀ":7}`, `{"ﬀ":7,"😀":6,"€":5,"a":4,"A":3,"1":2,"\r":1}`)
+
+		// Strings: minimal escaping, no HTML escaping, literal non-ASCII.
+		test(`"\u000f\n\"\\/<>&é€"`, `"\u000F\u000a\"\\\/<>&é€"`)
+
+		// Numbers are formatted like in JavaScript.
+		test(`[0,0,1,-1,10,1.5,100,1e+21,1e-7,0.000001,123456789012345680000,333333333.3333333]`,
+			`[0, -0, 1.0, -1, 1e1, 15e-1, 1E2, 1e21, 0.0000001, 1e-6, 123456789012345678901, 333333333.33333333]`)
+
+		// Example from RFC 8785 section 3.2.2.
+		test(
+			`{"literals":[null,true,false],"numbers":[333333333.3333333,1e+30,4.5,0.002,1e-27],"string":"€$\u000f\nA'B\"\\\\\"/"}`,
+			`{
+				"numbers": [333333333.33333329, 1E30, 4.50, 2e-3, 0.000000000000000000000000001],
+				"string": "€$\u000F\u000aA'B\u0022\\\\\"\/",
+				"literals": [null, true, false]
+			}`,
+		)
+
+		// Same data in different forms produces identical output.
+		eq(
+			tryNullJson(gt.ParseNullJson(`{"one": 1.0, "two": "two"}`).Canonical()),
+			tryNullJson(gt.ParseNullJson(`{"two":"two","one":1}`).Canonical()),
+		)
+
+		invalid := func(src string) {
+			t.Helper()
+			_, err := gt.ParseNullJson(src).Canonical()
+			fail(err)
+		}
+
+		invalid(`{"one": 1, "one": 2}`)
+		invalid(`[{"a": {"b": 1, "b": 2}}]`)
+		invalid(`1e400`)
+
+		// Bypasses validation in `.Parse`.
+		unchecked := func(src string) {
+			t.Helper()
+			_, err := gt.NullJson(src).Canonical()
+			fail(err)
+		}
+
+		unchecked(`{} x`)
+		unchecked(`{}}`)
+		unchecked(`{} {}`)
+		unchecked(`1 2`)
+		unchecked(`[1]]`)
+		eq(gt.NullJson(`{}`), tryNullJson(gt.NullJson(" {} \n").Canonical()))
+	})
+}
+
+func tryNullJson(val gt.NullJson, err error) gt.NullJson {
+	try(err)
+	return val
+}
@@ -0,0 +1,287 @@
+package gt
+
+import (
+	"database/sql/driver"
+	"time"
+)
+
+/*
+Shortcut for wrapping the given location. Nil results in zero. Unlike `.Scan`,
+doesn't validate the location. See `gt.NullLocation` for which locations are
+valid.
+*/
+func NullLocationFrom(val *time.Location) NullLocation { return NullLocation{val} }
+
+/*
+Shortcut: parses successfully or panics. Should be used only in root scope. When
+error handling is relevant, use `.Parse`.
+*/
+func ParseNullLocation(src string) (val NullLocation) {
+	try(val.Parse(src))
+	return
+}
+
+/*
+Nullable wrapper for `*time.Location`, encoded as the IANA zone name such as
+"Europe/Berlin" in text, JSON and SQL. Zero value (nil location) is considered
+empty in text, and null in JSON and SQL. Decoding validates the name via
+`time.LoadLocation`, caching loaded locations; safe for concurrent use.
+
+Only locations which can be loaded by name are valid, because only their names
+can be decoded. This excludes "Local", which depends on the environment, and
+fixed zones made by `time.FixedZone`.
+
+Suitable for user profiles and other records storing a preferred time zone.
+Helper methods such as `.Now`, `.Today` and `.Convert` treat zero as UTC.
+*/
+type NullLocation struct{ val *time.Location }
+
+var (
+	_ = Encodable(NullLocation{})
+	_ = Decodable((*NullLocation)(nil))
+)
+
+// Implement `gt.Zeroable`. Equivalent to `reflect.ValueOf(self).IsZero()`.
+func (self NullLocation) IsZero() bool { return self.val == nil }
+
+// Implement `gt.Nullable`. True if zero.
+func (self NullLocation) IsNull() bool { return self.IsZero() }
+
+/*
+Implement `gt.Getter`. If zero, returns `nil`, otherwise uses `.String` to
+return the zone name.
+*/
+func (self NullLocation) Get() any {
+	if self.IsNull() {
+		return nil
+	}
+	return self.String()
+}
+
+// Implement `gt.Setter`, using `.Scan`. Panics on error.
+func (self *NullLocation) Set(src any) { try(self.Scan(src)) }
+
+// Implement `gt.Zeroer`, zeroing the receiver.
+func (self *NullLocation) Zero() {
+	if self != nil {
+		*self = NullLocation{}
+	}
+}
+
+/*
+Implement `fmt.Stringer`. If zero, returns an empty string. Otherwise returns
+the zone name, same as `time.Location.String`.
+*/
+func (self NullLocation) String() string {
+	if self.IsNull() {
+		return ``
+	}
+	return self.val.String()
+}
+
+/*
+Implement `gt.Parser`. If the input is empty, zeroes the receiver. Otherwise
+requires a zone name accepted by `time.LoadLocation`, such as "UTC" or
+"Europe/Berlin". Rejects "Local".
+*/
+func (self *NullLocation) Parse(src string) (err error) {
+	if len(src) <= 0 {
+		self.Zero()
+		return nil
+	}
+
+	defer errParse(&err, src, `location`)
+
+	if src == time.Local.String() {
+		return errLocationLocal
+	}
+
+	val, err := loadLocation(src)
+	if err != nil {
+		return err
+	}
+
+	self.val = val
+	return nil
+}
+
+// Implement `gt.AppenderTo`, using the same representation as `.String`.
+func (self NullLocation) AppendTo(buf []byte) []byte {
+	return append(buf, self.String()...)
+}
+
+/*
+Implement `encoding.TextMarhaler`. If zero, returns nil. Otherwise returns the
+same representation as `.String`.
+*/
+func (self NullLocation) MarshalText() ([]byte, error) {
+	if self.IsNull() {
+		return nil, nil
+	}
+	return self.AppendTo(nil), nil
+}
+
+// Implement `encoding.TextUnmarshaler`, using the same algorithm as `.Parse`.
+func (self *NullLocation) UnmarshalText(src []byte) error {
+	return self.Parse(bytesString(src))
+}
+
+/*
+Implement `json.Marshaler`. If zero, returns bytes representing `null`.
+Otherwise returns bytes representing a JSON string with the same text as in
+`.String`.
+*/
+func (self NullLocation) MarshalJSON() ([]byte, error) {
+	if self.IsNull() {
+		return bytesNull, nil
+	}
+
+	buf := make([]byte, 0, len(self.val.String())+2)
+	buf = append(buf, '"')
+	buf = self.AppendTo(buf)
+	buf = append(buf, '"')
+	return buf, nil
+}
+
+/*
+Implement `json.Unmarshaler`. If the input is empty or represents JSON `null`,
+zeroes the receiver. Otherwise parses a JSON string, using the same algorithm
+as `.Parse`.
+*/
+func (self *NullLocation) UnmarshalJSON(src []byte) error {
+	if isJsonEmpty(src) {
+		self.Zero()
+		return nil
+	}
+
+	if isJsonStr(src) {
+		return self.UnmarshalText(cutJsonStr(src))
+	}
+
+	return errJsonString(src, self)
+}
+
+// Implement `driver.Valuer`, using `.Get`.
+func (self NullLocation) Value() (driver.Value, error) {
+	return self.Get(), nil
+}
+
+/*
+Implement `sql.Scanner`, converting an arbitrary input to `gt.NullLocation` and
+modifying the receiver. Acceptable inputs:
+
+  - `nil`             -> use `.Zero`
+  - `string`          -> use `.Parse`
+  - `[]byte`          -> use `.UnmarshalText`
+  - `*time.Location`  -> validate and assign
+  - `gt.NullLocation` -> assign
+  - `gt.Getter`       -> scan underlying value
+*/
+func (self *NullLocation) Scan(src any) error {
+	switch src := src.(type) {
+	case nil:
+		self.Zero()
+		return nil
+
+	case string:
+		return self.Parse(src)
+
+	case []byte:
+		return self.UnmarshalText(src)
+
+	case *time.Location:
+		if src == nil {
+			self.Zero()
+			return nil
+		}
+		if !isLocationLoadable(src) {
+			return errScanLocation(src)
+		}
+		self.val = src
+		return nil
+
+	case NullLocation:
+		*self = src
+		return nil
+
+	default:
+		val, ok := get(src)
+		if ok {
+			return self.Scan(val)
+		}
+		return errScanType(self, src)
+	}
+}
+
+/*
+True if the location can be loaded by its name, and the loaded location agrees
+with the original on the zone abbreviation and offset in winter and in summer.
+This rejects "Local" and fixed zones, including fixed zones named like real
+zones, such as `time.FixedZone("Europe/Berlin", 7200)`, whose abbreviation is
+the full name, and which lack DST.
+*/
+func isLocationLoadable(src *time.Location) bool {
+	name := src.String()
+	if name == `` || name == time.Local.String() {
+		return false
+	}
+
+	loaded, err := loadLocation(name)
+	if err != nil {
+		return false
+	}
+	if loaded == src {
+		return true
+	}
+
+	year := time.Now().Year()
+	for _, month := range [...]time.Month{time.January, time.July} {
+		inst := time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
+		oneName, oneOff := inst.In(src).Zone()
+		twoName, twoOff := inst.In(loaded).Zone()
+		if oneName != twoName || oneOff != twoOff {
+			return false
+		}
+	}
+	return true
+}
+
+// Returns the underlying location. If zero, returns nil.
+func (self NullLocation) Location() *time.Location { return self.val }
+
+// Returns the underlying location. If zero, returns `time.UTC`.
+func (self NullLocation) LocationOrUTC() *time.Location {
+	if self.IsNull() {
+		return time.UTC
+	}
+	return self.val
+}
+
+// Current time in this location. If zero, uses UTC.
+func (self NullLocation) Now() NullTime {
+	return NullTime(time.Now().In(self.LocationOrUTC()))
+}
+
+// Current civil date in this location. If zero, uses UTC.
+func (self NullLocation) Today() NullDate {
+	return self.Now().NullDate()
+}
+
+/*
+Converts the timestamp to this location, preserving the instant. If the
+timestamp is zero, returns zero. If the location is zero, uses UTC.
+*/
+func (self NullLocation) Convert(val NullTime) NullTime {
+	if val.IsZero() {
+		return val
+	}
+	return NullTime(val.Time().In(self.LocationOrUTC()))
+}
+
+/*
+Same as `.Convert`, but returns `gt.NullZonedTime`, which preserves the zone
+name when encoded.
+*/
+func (self NullLocation) Zoned(val NullTime) NullZonedTime {
+	return NullZonedTime(self.Convert(val))
+}
@@ -0,0 +1,111 @@
+package gt_test
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/mitranim/gt"
+)
+
+func TestNullLocation_common(t *testing.T) {
+	var (
+		primZero    = any(nil)
+		primNonZero = `Europe/Berlin`
+		textZero    = ``
+		textNonZero = primNonZero
+		jsonZero    = bytesNull
+		jsonNonZero = jsonBytes(textNonZero)
+		zero        = gt.NullLocation{}
+		nonZero     = gt.ParseNullLocation(textNonZero)
+		dec         = new(gt.NullLocation)
+	)
+
+	eq(true, zero.IsNull())
+	eq(false, nonZero.IsNull())
+
+	testAny(t, primZero, primNonZero, textZero, textNonZero, jsonZero, jsonNonZero, zero, nonZero, dec)
+}
+
+func TestNullLocation(t *testing.T) {
+	loc, err := time.LoadLocation(`Europe/Berlin`)
+	try(err)
+
+	t.Run(`Parse`, func(t *testing.T) {
+		eq(time.UTC, gt.ParseNullLocation(`UTC`).Location())
+		eq(`Europe/Berlin`, gt.ParseNullLocation(`Europe/Berlin`).String())
+
+		var val gt.NullLocation
+		fail(val.Parse(`Mars/Olympus`))
+		fail(val.Parse(`../../etc/passwd`))
+		fail(val.UnmarshalJSON([]byte(`123`)))
+		fail(val.Parse(`Local`))
+		fail(val.UnmarshalJSON([]byte(`"Local"`)))
+		eq(gt.NullLocation{}, val)
+	})
+
+	t.Run(`Scan`, func(t *testing.T) {
+		var val gt.NullLocation
+
+		try(val.Scan(loc))
+		eq(loc, val.Location())
+		try(val.Scan(time.UTC))
+		eq(time.UTC, val.Location())
+
+		// Equivalent to the cached location, but not the same pointer.
+		other, err := time.LoadLocation(`Europe/Berlin`)
+		try(err)
+		try(val.Scan(other))
+		eq(other, val.Location())
+		try(val.Scan((*time.Location)(nil)))
+		eq(gt.NullLocation{}, val)
+
+		fail(val.Scan(time.Local))
+		fail(val.Scan(time.FixedZone(`UTC+3`, 3*60*60)))
+		fail(val.Scan(time.FixedZone(``, 3*60*60)))
+		fail(val.Scan(time.FixedZone(`UTC`, 3*60*60)))
+		fail(val.Scan(time.FixedZone(`Europe/Berlin`, 5*60*60)))
+		fail(val.Scan(time.FixedZone(`Europe/Berlin`, 1*60*60)))
+		fail(val.Scan(time.FixedZone(`Europe/Berlin`, 2*60*60)))
+		fail(val.Scan(time.FixedZone(`Asia/Tokyo`, 9*60*60)))
+		eq(gt.NullLocation{}, val)
+
+		// Every scanned location round-trips through text.
+		try(val.Scan(loc))
+		eq(loc.String(), gt.ParseNullLocation(val.String()).String())
+	})
+
+	t.Run(`cache`, func(t *testing.T) {
+		var group sync.WaitGroup
+		out := make([]*time.Location, 8)
+
+		for ind := range out {
+			group.Add(1)
+			go func(ind int) {
+				defer group.Done()
+				out[ind] = gt.ParseNullLocation(`Asia/Tokyo`).Location()
+			}(ind)
+		}
+		group.Wait()
+
+		for _, val := range out {
+			eq(true, val == gt.ParseNullLocation(`Asia/Tokyo`).Location())
+		}
+	})
+
+	t.Run(`Convert`, func(t *testing.T) {
+		val := gt.NullLocationFrom(loc)
+
+		eq(gt.NullTime{}, val.Convert(gt.NullTime{}))
+		eq(gt.NullTimeIn(2024, 5, 1, 10, 0, 0, 0, loc), val.Convert(gt.NullTimeUTC(2024, 5, 1, 8, 0, 0, 0)))
+		eq(gt.NullTimeUTC(2024, 5, 1, 8, 0, 0, 0), gt.NullLocation{}.Convert(gt.NullTimeUTC(2024, 5, 1, 8, 0, 0, 0)))
+		eq(`2024-05-01T10:00:00+02:00[Europe/Berlin]`, val.Zoned(gt.NullTimeUTC(2024, 5, 1, 8, 0, 0, 0)).String())
+	})
+
+	t.Run(`Now`, func(t *testing.T) {
+		val := gt.NullLocationFrom(loc)
+		eq(loc, val.Now().Location())
+		eq(time.UTC, gt.NullLocation{}.Now().Location())
+		eq(false, val.Today().IsNull())
+	})
+}
@@ -0,0 +1,353 @@
+package gt
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"net"
+)
+
+// Length of EUI-48 MAC address in bytes.
+const MacLen48 = 6
+
+// Length of EUI-64 MAC address in bytes.
+const MacLen64 = 8
+
+/*
+Shortcut: parses successfully or panics. Should be used only in root scope. When
+error handling is relevant, use `.Parse`.
+*/
+func ParseNullMac(src string) (val NullMac) {
+	try(val.Parse(src))
+	return
+}
+
+// Creates an EUI-48 MAC address from the given bytes.
+func NullMacFrom48(src [MacLen48]byte) (out NullMac) {
+	copy(out.val[:], src[:])
+	out.size = MacLen48
+	return
+}
+
+// Creates an EUI-64 MAC address from the given bytes.
+func NullMacFrom64(src [MacLen64]byte) NullMac {
+	return NullMac{src, MacLen64}
+}
+
+/*
+MAC address (EUI-48 or EUI-64) where zero value is considered empty in text,
+and null in JSON and SQL. Compatible with Postgres `macaddr` (EUI-48) and
+`macaddr8` (both). Unlike `net.HardwareAddr`, this type is comparable and
+doesn't allocate. The all-zero address "00:00:00:00:00:00" is distinct from
+null.
+
+Text encoding uses the canonical lowercase colon-separated format, such as
+"08:00:2b:01:02:03". Text decoding is case-insensitive and supports all common
+notations, also supported by Postgres:
+
+	08:00:2b:01:02:03
+	08-00-2b-01-02-03
+	0800.2b01.0203
+	0800-2b01-0203
+	08002b:010203
+	08002b010203
+
+The same notations apply to EUI-64 with 8 bytes.
+*/
+type NullMac struct {
+	val  [MacLen64]byte
+	size uint8
+}
+
+var (
+	_ = Encodable(NullMac{})
+	_ = Decodable((*NullMac)(nil))
+)
+
+// Implement `gt.Zeroable`. True if the address is empty.
+func (self NullMac) IsZero() bool { return self == NullMac{} }
+
+// Implement `gt.Nullable`. True if zero.
+func (self NullMac) IsNull() bool { return self.IsZero() }
+
+/*
+Implement `gt.Getter`. If zero, returns `nil`, otherwise uses `.String` to
+return a string representation.
+*/
+func (self NullMac) Get() any {
+	if self.IsNull() {
+		return nil
+	}
+	return self.String()
+}
+
+// Implement `gt.Setter`, using `.Scan`. Panics on error.
+func (self *NullMac) Set(src any) { try(self.Scan(src)) }
+
+// Implement `gt.Zeroer`, zeroing the receiver.
+func (self *NullMac) Zero() {
+	if self != nil {
+		*self = NullMac{}
+	}
+}
+
+/*
+Implement `fmt.Stringer`. If zero, returns an empty string. Otherwise returns
+the canonical lowercase colon-separated representation.
+*/
+func (self NullMac) String() string {
+	if self.IsNull() {
+		return ``
+	}
+	return bytesString(self.AppendTo(nil))
+}
+
+/*
+Implement `gt.Parser`. If the input is empty, zeroes the receiver. Otherwise
+parses any of the notations listed in the type's description.
+*/
+func (self *NullMac) Parse(src string) (err error) {
+	if len(src) <= 0 {
+		self.Zero()
+		return nil
+	}
+
+	defer errParse(&err, src, `MAC address`)
+
+	val, err := macParse(src)
+	if err != nil {
+		return err
+	}
+
+	*self = val
+	return nil
+}
+
+// Implement `gt.AppenderTo`, using the same representation as `.String`.
+func (self NullMac) AppendTo(buf []byte) []byte {
+	for ind, char := range self.val[:self.size] {
+		if ind > 0 {
+			buf = append(buf, ':')
+		}
+		buf = append(buf, hexLower[char>>4], hexLower[char&0xf])
+	}
+	return buf
+}
+
+/*
+Implement `encoding.TextMarhaler`. If zero, returns nil. Otherwise returns the
+same representation as `.String`.
+*/
+func (self NullMac) MarshalText() ([]byte, error) {
+	if self.IsNull() {
+		return nil, nil
+	}
+	return self.AppendTo(nil), nil
+}
+
+// Implement `encoding.TextUnmarshaler`, using the same algorithm as `.Parse`.
+func (self *NullMac) UnmarshalText(src []byte) error {
+	return self.Parse(bytesString(src))
+}
+
+/*
+Implement `json.Marshaler`. If zero, returns bytes representing `null`.
+Otherwise returns bytes representing a JSON string with the same text as in
+`.String`.
+*/
+func (self NullMac) MarshalJSON() ([]byte, error) {
+	if self.IsNull() {
+		return bytesNull, nil
+	}
+
+	buf := make([]byte, 0, MacLen64*3+1)
+	buf = append(buf, '"')
+	buf = self.AppendTo(buf)
+	buf = append(buf, '"')
+	return buf, nil
+}
+
+/*
+Implement `json.Unmarshaler`. If the input is empty or represents JSON `null`,
+zeroes the receiver. Otherwise parses a JSON string, using the same algorithm
+as `.Parse`.
+*/
+func (self *NullMac) UnmarshalJSON(src []byte) error {
+	if isJsonEmpty(src) {
+		self.Zero()
+		return nil
+	}
+	if isJsonStr(src) {
+		return self.UnmarshalText(cutJsonStr(src))
+	}
+	return errJsonString(src, self)
+}
+
+// Implement `driver.Valuer`, using `.Get`.
+func (self NullMac) Value() (driver.Value, error) {
+	return self.Get(), nil
+}
+
+/*
+Implement `sql.Scanner`, converting an arbitrary input to `gt.NullMac` and
+modifying the receiver. Acceptable inputs:
+
+  - `nil`              -> use `.Zero`
+  - `string`           -> use `.Parse`
+  - `[]byte`           -> use `.UnmarshalText`
+  - `net.HardwareAddr` -> copy 6 or 8 bytes, or use `.Zero` if empty
+  - `gt.NullMac`       -> assign
+  - `gt.Getter`        -> scan underlying value
+*/
+func (self *NullMac) Scan(src any) error {
+	switch src := src.(type) {
+	case nil:
+		self.Zero()
+		return nil
+
+	case string:
+		return self.Parse(src)
+
+	case []byte:
+		return self.UnmarshalText(src)
+
+	case net.HardwareAddr:
+		switch len(src) {
+		case 0:
+			self.Zero()
+		case MacLen48, MacLen64:
+			*self = NullMac{}
+			copy(self.val[:], src)
+			self.size = uint8(len(src))
+		default:
+			return fmt.Errorf(`[gt] unable to convert %v to MAC address: %w`, src, errUnrecLength)
+		}
+		return nil
+
+	case NullMac:
+		*self = src
+		return nil
+
+	default:
+		val, ok := get(src)
+		if ok {
+			return self.Scan(val)
+		}
+		return errScanType(self, src)
+	}
+}
+
+// Returns the length in bytes: 0 if zero, 6 for EUI-48, 8 for EUI-64.
+func (self NullMac) Len() int { return int(self.size) }
+
+// True if the address is EUI-48, suitable for Postgres `macaddr`.
+func (self NullMac) Is48() bool { return self.size == MacLen48 }
+
+// True if the address is EUI-64.
+func (self NullMac) Is64() bool { return self.size == MacLen64 }
+
+// Returns the address bytes as a new slice. If zero, returns nil.
+func (self NullMac) Bytes() []byte {
+	if self.IsNull() {
+		return nil
+	}
+	return append([]byte(nil), self.val[:self.size]...)
+}
+
+// Converts to `net.HardwareAddr`. If zero, returns nil.
+func (self NullMac) HardwareAddr() net.HardwareAddr {
+	return net.HardwareAddr(self.Bytes())
+}
+
+/*
+Returns the Organizationally Unique Identifier: the first 3 bytes, which
+identify the vendor for universally administered addresses.
+*/
+func (self NullMac) Oui() (out [3]byte) {
+	copy(out[:], self.val[:])
+	return
+}
+
+/*
+True if the address is a group (multicast or broadcast) address: the least
+significant bit of the first byte (I/G bit) is set. False if zero.
+*/
+func (self NullMac) IsMulticast() bool {
+	return !self.IsNull() && self.val[0]&0b01 != 0
+}
+
+/*
+True if the address is locally administered rather than assigned by the
+vendor: the second least significant bit of the first byte (U/L bit) is set.
+Randomized addresses used by mobile devices are locally administered. False if
+zero.
+*/
+func (self NullMac) IsLocal() bool {
+	return !self.IsNull() && self.val[0]&0b10 != 0
+}
+
+/*
+Converts EUI-48 to EUI-64 by inserting FF:FE in the middle, the same way as
+Postgres does when casting `macaddr` to `macaddr8`. Zero and EUI-64 addresses
+are returned as-is.
+*/
+func (self NullMac) Eui64() NullMac {
+	if !self.Is48() {
+		return self
+	}
+	val := self.val
+	return NullMacFrom64([MacLen64]byte{val[0], val[1], val[2], 0xff, 0xfe, val[3], val[4], val[5]})
+}
+
+/*
+Parses a MAC address with an optional separator, which must be used
+consistently and must split the hex digits into equal groups of 2, 4 or 6.
+*/
+func macParse(src string) (out NullMac, err error) {
+	var digits [MacLen64 * 2]byte
+	var count int
+	var sep byte
+	var width int
+	var group int
+
+	for ind := 0; ind < len(src); ind++ {
+		char := src[ind]
+
+		if hexBools[char] {
+			if count >= len(digits) {
+				return out, errUnrecLength
+			}
+			digits[count] = char
+			count++
+			group++
+			continue
+		}
+
+		if sep == 0 && (char == ':' || char == '-' || char == '.') {
+			sep = char
+		}
+		if char != sep {
+			return out, errInvalidCharAt(src, ind)
+		}
+
+		if width == 0 {
+			width = group
+		}
+		if group == 0 || group != width {
+			return out, errFormatMismatch
+		}
+		group = 0
+	}
+
+	if sep != 0 && (group != width || !(width == 2 || width == 4 || width == 6)) {
+		return out, errFormatMismatch
+	}
+	if count != MacLen48*2 && count != MacLen64*2 {
+		return out, errUnrecLength
+	}
+
+	out.size = uint8(count / 2)
+	for ind := range out.val[:out.size] {
+		out.val[ind], _ = hexDecode(digits[ind*2], digits[ind*2+1])
+	}
+	return out, nil
+}
@@ -0,0 +1,115 @@
+package gt_test
+
+import (
+	"net"
+	"testing"
+
+	"github.com/mitranim/gt"
+)
+
+func TestNullMac_common(t *testing.T) {
+	var (
+		primZero    = any(nil)
+		primNonZero = `08:00:2b:01:02:03`
+		textZero    = ``
+		textNonZero = `08:00:2b:01:02:03`
+		jsonZero    = bytesNull
+		jsonNonZero = []byte(`"08:00:2b:01:02:03"`)
+		zero        = gt.NullMac{}
+		nonZero     = gt.NullMacFrom48([6]byte{0x08, 0x00, 0x2b, 0x01, 0x02, 0x03})
+		dec         = new(gt.NullMac)
+	)
+
+	eq(true, zero.IsNull())
+	eq(false, nonZero.IsNull())
+
+	testAny(t, primZero, primNonZero, textZero, textNonZero, jsonZero, jsonNonZero, zero, nonZero, dec)
+}
+
+func TestNullMac(t *testing.T) {
+	t.Run(`Parse`, func(t *testing.T) {
+		test := func(exp, src string) {
+			t.Helper()
+			eq(exp, gt.ParseNullMac(src).String())
+		}
+
+		const mac48 = `08:00:2b:01:02:03`
+		test(``, ``)
+		test(mac48, `08:00:2b:01:02:03`)
+		test(mac48, `08-00-2B-01-02-03`)
+		test(mac48, `0800.2b01.0203`)
+		test(mac48, `0800-2b01-0203`)
+		test(mac48, `08002b:010203`)
+		test(mac48, `08002B010203`)
+		test(`00:00:00:00:00:00`, `000000000000`)
+
+		const mac64 = `08:00:2b:01:02:03:04:05`
+		test(mac64, `08:00:2b:01:02:03:04:05`)
+		test(mac64, `08-00-2b-01-02-03-04-05`)
+		test(mac64, `0800.2b01.0203.0405`)
+		test(mac64, `08002b0102030405`)
+
+		var val gt.NullMac
+		fail(val.Parse(`08:00:2b:01:02`))
+		fail(val.Parse(`08:00:2b:01:02:03:04`))
+		fail(val.Parse(`08:00:2b:01:02:03:04:05:06`))
+		fail(val.Parse(`08:00:2b-01:02:03`))
+		fail(val.Parse(`08:00:2b:01:02:0g`))
+		fail(val.Parse(`0800:2b:01:0203`))
+		fail(val.Parse(`080:02b:010:203`))
+		fail(val.Parse(`08002b:0102030405`))
+		fail(val.Parse(`:08:00:2b:01:02:03`))
+		fail(val.Parse(`08:00:2b:01:02:03:`))
+		fail(val.Parse(`08::00:2b:01:02:03`))
+		fail(val.Parse(` 08:00:2b:01:02:03`))
+		eq(gt.NullMac{}, val)
+	})
+
+	t.Run(`methods`, func(t *testing.T) {
+		val := gt.ParseNullMac(`08:00:2b:01:02:03`)
+
+		eq(6, val.Len())
+		eq(true, val.Is48())
+		eq(false, val.Is64())
+		eq([3]byte{0x08, 0x00, 0x2b}, val.Oui())
+		eq([]byte{0x08, 0x00, 0x2b, 0x01, 0x02, 0x03}, val.Bytes())
+		eq(net.HardwareAddr{0x08, 0x00, 0x2b, 0x01, 0x02, 0x03}, val.HardwareAddr())
+		eq(false, val.IsMulticast())
+		eq(false, val.IsLocal())
+
+		eq(`08:00:2b:ff:fe:01:02:03`, val.Eui64().String())
+		eq(true, val.Eui64().Is64())
+		eq(gt.NullMac{}, gt.NullMac{}.Eui64())
+
+		eq(true, gt.ParseNullMac(`01:00:5e:00:00:01`).IsMulticast())
+		eq(true, gt.ParseNullMac(`ff:ff:ff:ff:ff:ff`).IsMulticast())
+		eq(true, gt.ParseNullMac(`02:00:00:00:00:01`).IsLocal())
+		eq(false, gt.ParseNullMac(`02:00:00:00:00:01`).IsMulticast())
+
+		eq(0, gt.NullMac{}.Len())
+		eq([]byte(nil), gt.NullMac{}.Bytes())
+		eq(false, gt.NullMac{}.IsMulticast())
+		eq(false, gt.ParseNullMac(`00:00:00:00:00:00`).IsNull())
+		eq(`08:00:2b:01:02:03:04:05`, gt.NullMacFrom64([8]byte{8, 0, 0x2b, 1, 2, 3, 4, 5}).String())
+	})
+
+	t.Run(`Scan`, func(t *testing.T) {
+		test := func(exp string, src any) {
+			t.Helper()
+			var val gt.NullMac
+			try(val.Scan(src))
+			eq(exp, val.String())
+		}
+
+		test(``, nil)
+		test(`08:00:2b:01:02:03`, `08002b:010203`)
+		test(`08:00:2b:01:02:03`, []byte(`08-00-2b-01-02-03`))
+		test(`08:00:2b:01:02:03`, net.HardwareAddr{0x08, 0x00, 0x2b, 0x01, 0x02, 0x03})
+		test(``, net.HardwareAddr(nil))
+		test(`08:00:2b:01:02:03`, gt.NullString(`0800.2b01.0203`))
+
+		var val gt.NullMac
+		fail(val.Scan(net.HardwareAddr{1, 2, 3}))
+		fail(val.Scan(int64(1)))
+	})
+}
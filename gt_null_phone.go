@@ -0,0 +1,308 @@
+package gt
+
+import (
+	"database/sql/driver"
+	"strings"
+)
+
+/*
+Shortcut: parses successfully or panics. Should be used only in root scope. When
+error handling is relevant, use `.Parse`.
+*/
+func ParseNullPhone(src string) (val NullPhone) {
+	try(val.Parse(src))
+	return
+}
+
+/*
+Phone number in the international E.164 format, such as "+14155552671", where
+zero value is considered empty in text, and null in JSON and SQL. Text, JSON
+and SQL always use the canonical E.164 form: "+" followed by up to 15 digits,
+starting with the country calling code.
+
+Decoding accepts numbers as typed by humans: digits may be separated by spaces,
+dashes, dots, slashes and parentheses, and the number must start with the
+international prefix "+" or "00". The trunk prefix notation "(0)", such as in
+"+44 (0)20 7946 0958", is removed. Numbers without an international prefix are
+rejected, because their country can't be determined. Decoding also validates
+the country calling code and the length of the number. The length check is
+exact for some common codes such as +1 (NANP), and otherwise only enforces the
+general E.164 limits. Extensions are not supported.
+
+For display, use `.Grouped`, which splits the number into groups of digits.
+*/
+type NullPhone string
+
+var (
+	_ = Encodable(NullPhone(``))
+	_ = Decodable((*NullPhone)(nil))
+)
+
+var charsetPhoneSep = new(charset).add(" \t-./()")
+
+// Implement `gt.Zeroable`. True if empty.
+func (self NullPhone) IsZero() bool { return self == `` }
+
+// Implement `gt.Nullable`. True if empty.
+func (self NullPhone) IsNull() bool { return self.IsZero() }
+
+// Implement `gt.Getter`. If zero, returns `nil`, otherwise returns `string`.
+func (self NullPhone) Get() any {
+	if self.IsNull() {
+		return nil
+	}
+	return string(self)
+}
+
+// Implement `gt.Setter`, using `.Scan`. Panics on error.
+func (self *NullPhone) Set(src any) { try(self.Scan(src)) }
+
+// Implement `gt.Zeroer`, zeroing the receiver.
+func (self *NullPhone) Zero() {
+	if self != nil {
+		*self = ``
+	}
+}
+
+// Implement `fmt.Stringer`, returning the canonical E.164 form.
+func (self NullPhone) String() string {
+	return string(self)
+}
+
+/*
+Implement `gt.Parser`. If the input is empty, zeroes the receiver. Otherwise
+parses the input as described in the type's description, and assigns the
+canonical E.164 form.
+*/
+func (self *NullPhone) Parse(src string) (err error) {
+	if len(src) <= 0 {
+		self.Zero()
+		return nil
+	}
+
+	defer errParse(&err, src, `phone number`)
+
+	val, err := phoneParse(src)
+	if err != nil {
+		return err
+	}
+
+	*self = val
+	return nil
+}
+
+// Implement `gt.AppenderTo`, appending the canonical E.164 form.
+func (self NullPhone) AppendTo(buf []byte) []byte {
+	return append(buf, self...)
+}
+
+/*
+Implement `encoding.TextMarhaler`. If zero, returns nil. Otherwise returns the
+same representation as `.String`.
+*/
+func (self NullPhone) MarshalText() ([]byte, error) {
+	if self.IsNull() {
+		return nil, nil
+	}
+	return self.AppendTo(nil), nil
+}
+
+// Implement `encoding.TextUnmarshaler`, using the same algorithm as `.Parse`.
+func (self *NullPhone) UnmarshalText(src []byte) error {
+	// Safe because `.Parse` always allocates a new string.
+	return self.Parse(bytesString(src))
+}
+
+/*
+Implement `json.Marshaler`. If zero, returns bytes representing `null`.
+Otherwise returns bytes representing a JSON string with the same text as in
+`.String`.
+*/
+func (self NullPhone) MarshalJSON() ([]byte, error) {
+	if self.IsNull() {
+		return bytesNull, nil
+	}
+
+	buf := make([]byte, 0, len(self)+2)
+	buf = append(buf, '"')
+	buf = self.AppendTo(buf)
+	buf = append(buf, '"')
+	return buf, nil
+}
+
+/*
+Implement `json.Unmarshaler`. If the input is empty or represents JSON `null`,
+zeroes the receiver. Otherwise parses a JSON string, using the same algorithm
+as `.Parse`.
+*/
+func (self *NullPhone) UnmarshalJSON(src []byte) error {
+	if isJsonEmpty(src) {
+		self.Zero()
+		return nil
+	}
+	if isJsonStr(src) {
+		return self.UnmarshalText(cutJsonStr(src))
+	}
+	return errJsonString(src, self)
+}
+
+// Implement `driver.Valuer`, using `.Get`.
+func (self NullPhone) Value() (driver.Value, error) { return self.Get(), nil }
+
+/*
+Implement `sql.Scanner`, converting an arbitrary input to `gt.NullPhone` and
+modifying the receiver. Acceptable inputs:
+
+  - `nil`           -> use `.Zero`
+  - `string`        -> use `.Parse`
+  - `[]byte`        -> use `.UnmarshalText`
+  - `gt.NullPhone`  -> assign
+  - `gt.NullString` -> use `.Parse`
+  - `gt.Getter`     -> scan underlying value
+*/
+func (self *NullPhone) Scan(src any) error {
+	switch src := src.(type) {
+	case nil:
+		self.Zero()
+		return nil
+
+	case string:
+		return self.Parse(src)
+
+	case []byte:
+		return self.UnmarshalText(src)
+
+	case NullPhone:
+		*self = src
+		return nil
+
+	case NullString:
+		return self.Parse(string(src))
+
+	default:
+		val, ok := get(src)
+		if ok {
+			return self.Scan(val)
+		}
+		return errScanType(self, src)
+	}
+}
+
+// Returns the country calling code without "+", such as "1" or "44". If zero, returns "".
+func (self NullPhone) CountryCode() string {
+	digits := self.digits()
+	return digits[:phoneCodeLen(digits)]
+}
+
+/*
+Returns the national significant number: the digits after the country calling
+code, without the trunk prefix. If zero, returns "".
+*/
+func (self NullPhone) National() string {
+	digits := self.digits()
+	return digits[phoneCodeLen(digits):]
+}
+
+/*
+Returns a representation suitable for display, with the country calling code
+and groups of digits separated by spaces, such as "+1 415 555 2671" or
+"+44 207 946 0958". Grouping is generic rather than following the national
+conventions of each country. If zero, returns "".
+*/
+func (self NullPhone) Grouped() string {
+	if self.IsNull() {
+		return ``
+	}
+
+	national := self.National()
+	buf := make([]byte, 0, len(self)+len(national)/2+2)
+	buf = append(buf, '+')
+	buf = append(buf, self.CountryCode()...)
+
+	for _, size := range phoneGroups(len(national)) {
+		buf = append(buf, ' ')
+		buf = append(buf, national[:size]...)
+		national = national[size:]
+	}
+	return bytesString(buf)
+}
+
+func (self NullPhone) digits() string {
+	return strings.TrimPrefix(string(self), `+`)
+}
+
+/*
+Splits the national number into groups of 3 digits, followed by 2 nearly equal
+groups of at most 4 digits, such as 3-3-4 for 10 digits or 4-4 for 8 digits.
+*/
+func phoneGroups(size int) (out []int) {
+	for size > 8 {
+		out = append(out, 3)
+		size -= 3
+	}
+	if size <= 4 {
+		return append(out, size)
+	}
+	return append(out, size/2, size-size/2)
+}
+
+func phoneParse(src string) (NullPhone, error) {
+	// Room for the "00" prefix.
+	var buf [phoneDigitsMax + 2]byte
+	var size int
+	var plus bool
+
+	for ind := 0; ind < len(src); ind++ {
+		char := src[ind]
+
+		if charsetDigitDec.has(char) {
+			if size >= len(buf) {
+				return ``, errUnrecLength
+			}
+			buf[size] = char
+			size++
+			continue
+		}
+
+		if char == '+' && !plus && size == 0 {
+			plus = true
+			continue
+		}
+
+		if char == '(' && size > 0 && strings.HasPrefix(src[ind:], `(0)`) {
+			ind += 2
+			continue
+		}
+
+		if !charsetPhoneSep.has(char) {
+			return ``, errInvalidCharAt(src, ind)
+		}
+	}
+
+	digits := string(buf[:size])
+	if !plus {
+		if !strings.HasPrefix(digits, `00`) {
+			return ``, errPhonePrefix
+		}
+		digits = digits[2:]
+	}
+
+	if len(digits) > phoneDigitsMax {
+		return ``, errUnrecLength
+	}
+
+	codeLen := phoneCodeLen(digits)
+	if codeLen == 0 {
+		return ``, errPhoneCode
+	}
+
+	lens, ok := phoneNationalLens[digits[:codeLen]]
+	if !ok {
+		lens = [2]int{phoneNationalMin, phoneDigitsMax - codeLen}
+	}
+	if national := len(digits) - codeLen; national < lens[0] || national > lens[1] {
+		return ``, errUnrecLength
+	}
+
+	return NullPhone(`+` + digits), nil
+}
@@ -0,0 +1,107 @@
+package gt_test
+
+import (
+	"testing"
+
+	"github.com/mitranim/gt"
+)
+
+func TestNullPhone_common(t *testing.T) {
+	var (
+		primZero    = any(nil)
+		primNonZero = `+14155552671`
+		textZero    = ``
+		textNonZero = `+14155552671`
+		jsonZero    = bytesNull
+		jsonNonZero = []byte(`"+14155552671"`)
+		zero        = gt.NullPhone(``)
+		nonZero     = gt.NullPhone(`+14155552671`)
+		dec         = new(gt.NullPhone)
+	)
+
+	eq(true, zero.IsNull())
+	eq(false, nonZero.IsNull())
+
+	testAny(t, primZero, primNonZero, textZero, textNonZero, jsonZero, jsonNonZero, zero, nonZero, dec)
+}
+
+func TestNullPhone(t *testing.T) {
+	t.Run(`Parse`, func(t *testing.T) {
+		test := func(exp, src string) {
+			t.Helper()
+			eq(gt.NullPhone(exp), gt.ParseNullPhone(src))
+		}
+
+		test(``, ``)
+		test(`+14155552671`, `+14155552671`)
+		test(`+14155552671`, `+1 (415) 555-2671`)
+		test(`+14155552671`, `+1.415.555.2671`)
+		test(`+14155552671`, `001 415 555 2671`)
+		test(`+14155552671`, ` +1 415/555-2671 `)
+		test(`+442079460958`, `+44 (0)20 7946 0958`)
+		test(`+442079460958`, `0044 20 7946 0958`)
+		test(`+33123456789`, `+33 1 23 45 67 89`)
+		test(`+4930123456`, `+49 30 123456`)
+		test(`+8613800138000`, `+86 138 0013 8000`)
+		test(`+6834001`, `+683 4001`)
+		test(`+380441234567`, `+380 44 123 4567`)
+		test(`+491234567890123`, `+49 1234 5678 9012 3`)
+
+		var val gt.NullPhone
+		fail(val.Parse(`4155552671`))
+		fail(val.Parse(`0 415 555 2671`))
+		fail(val.Parse(`+`))
+		fail(val.Parse(`00`))
+		fail(val.Parse(`+1 415 555 267`))
+		fail(val.Parse(`+1 415 555 26711`))
+		fail(val.Parse(`+44 20 79`))
+		fail(val.Parse(`+49 1234 5678 9012 34`))
+		fail(val.Parse(`+0 123 456 789`))
+		fail(val.Parse(`+28 123 456 789`))
+		fail(val.Parse(`+1 415 555 2671 ext 12`))
+		fail(val.Parse(`+1 415 555 267a`))
+		fail(val.Parse(`1+ 415 555 2671`))
+		fail(val.Parse(`++1 415 555 2671`))
+		fail(val.Parse(`+1 415 555 2671 1234 5678 9012`))
+		eq(gt.NullPhone(``), val)
+	})
+
+	t.Run(`parts`, func(t *testing.T) {
+		test := func(code, national, grouped, src string) {
+			t.Helper()
+			val := gt.ParseNullPhone(src)
+			eq(code, val.CountryCode())
+			eq(national, val.National())
+			eq(grouped, val.Grouped())
+		}
+
+		test(``, ``, ``, ``)
+		test(`1`, `4155552671`, `+1 415 555 2671`, `+14155552671`)
+		test(`44`, `2079460958`, `+44 207 946 0958`, `+442079460958`)
+		test(`33`, `123456789`, `+33 123 456 789`, `+33123456789`)
+		test(`86`, `13800138000`, `+86 138 0013 8000`, `+8613800138000`)
+		test(`380`, `441234567`, `+380 441 234 567`, `+380441234567`)
+		test(`49`, `30123456`, `+49 3012 3456`, `+4930123456`)
+		test(`683`, `4001`, `+683 4001`, `+6834001`)
+		test(`372`, `51234`, `+372 51 234`, `+37251234`)
+	})
+
+	t.Run(`Scan`, func(t *testing.T) {
+		test := func(exp string, src any) {
+			t.Helper()
+			var val gt.NullPhone
+			try(val.Scan(src))
+			eq(gt.NullPhone(exp), val)
+		}
+
+		test(``, nil)
+		test(`+14155552671`, `+1 415 555 2671`)
+		test(`+14155552671`, []byte(`001-415-555-2671`))
+		test(`+14155552671`, gt.NullString(`+1 (415) 555 2671`))
+		test(`+14155552671`, gt.NullPhone(`+14155552671`))
+
+		var val gt.NullPhone
+		fail(val.Scan(`4155552671`))
+		fail(val.Scan(int64(14155552671)))
+	})
+}
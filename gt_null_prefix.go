@@ -0,0 +1,293 @@
+package gt
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"net"
+	"net/netip"
+	"strings"
+)
+
+/*
+Shortcut: parses successfully or panics. Should be used only in root scope. When
+error handling is relevant, use `.Parse`.
+*/
+func ParseNullPrefix(src string) (val NullPrefix) {
+	try(val.Parse(src))
+	return
+}
+
+// Free cast from `netip.Prefix`.
+func NullPrefixFrom(src netip.Prefix) NullPrefix { return NullPrefix(src) }
+
+/*
+IP network prefix (CIDR notation) where zero value is considered empty in text,
+and null in JSON and SQL. Wraps `netip.Prefix`. Text and JSON use the same
+representation as `netip.Prefix.String`, such as "192.168.0.0/24".
+
+Compatible with Postgres `inet` and `cidr`. Like `inet`, preserves host bits:
+"192.168.0.1/24" is a host address with a netmask, and `.Masked` converts it to
+the network "192.168.0.0/24", which is the form required by `cidr`. When
+decoding, an address without a mask, as in Postgres `inet` output, is treated
+as a single-address prefix such as "192.168.0.1/32". IPv6 zones are not
+supported.
+*/
+type NullPrefix netip.Prefix
+
+var (
+	_ = Encodable(NullPrefix{})
+	_ = Decodable((*NullPrefix)(nil))
+)
+
+// Implement `gt.Zeroable`. True if the prefix is the zero `netip.Prefix`.
+func (self NullPrefix) IsZero() bool { return self == NullPrefix{} }
+
+// Implement `gt.Nullable`. True if zero.
+func (self NullPrefix) IsNull() bool { return self.IsZero() }
+
+/*
+Implement `gt.Getter`. If zero, returns `nil`, otherwise uses `.String` to
+return a string representation.
+*/
+func (self NullPrefix) Get() any {
+	if self.IsNull() {
+		return nil
+	}
+	return self.String()
+}
+
+// Implement `gt.Setter`, using `.Scan`. Panics on error.
+func (self *NullPrefix) Set(src any) { try(self.Scan(src)) }
+
+// Implement `gt.Zeroer`, zeroing the receiver.
+func (self *NullPrefix) Zero() {
+	if self != nil {
+		*self = NullPrefix{}
+	}
+}
+
+/*
+Implement `fmt.Stringer`. If zero, returns an empty string. Otherwise formats
+using `netip.Prefix.String`.
+*/
+func (self NullPrefix) String() string {
+	if self.IsNull() {
+		return ``
+	}
+	return self.Prefix().String()
+}
+
+/*
+Implement `gt.Parser`. If the input is empty, zeroes the receiver. Otherwise
+parses the input using `netip.ParsePrefix`, preserving host bits. An address
+without a mask is treated as a single-address prefix.
+*/
+func (self *NullPrefix) Parse(src string) (err error) {
+	if len(src) <= 0 {
+		self.Zero()
+		return nil
+	}
+
+	defer errParse(&err, src, `IP prefix`)
+
+	if strings.IndexByte(src, '%') >= 0 {
+		return errPrefixZone
+	}
+
+	var val netip.Prefix
+	if strings.IndexByte(src, '/') >= 0 {
+		val, err = netip.ParsePrefix(src)
+		if err != nil {
+			return err
+		}
+	} else {
+		addr, err := netip.ParseAddr(src)
+		if err != nil {
+			return err
+		}
+		val = netip.PrefixFrom(addr, addr.BitLen())
+	}
+
+	*self = NullPrefix(val)
+	return nil
+}
+
+// Implement `gt.AppenderTo`, using the same representation as `.String`.
+func (self NullPrefix) AppendTo(buf []byte) []byte {
+	if self.IsNull() {
+		return buf
+	}
+	return self.Prefix().AppendTo(buf)
+}
+
+/*
+Implement `encoding.TextMarhaler`. If zero, returns nil. Otherwise returns the
+same representation as `.String`.
+*/
+func (self NullPrefix) MarshalText() ([]byte, error) {
+	if self.IsNull() {
+		return nil, nil
+	}
+	return self.AppendTo(nil), nil
+}
+
+// Implement `encoding.TextUnmarshaler`, using the same algorithm as `.Parse`.
+func (self *NullPrefix) UnmarshalText(src []byte) error {
+	return self.Parse(bytesString(src))
+}
+
+/*
+Implement `json.Marshaler`. If zero, returns bytes representing `null`.
+Otherwise returns bytes representing a JSON string with the same text as in
+`.String`.
+*/
+func (self NullPrefix) MarshalJSON() ([]byte, error) {
+	if self.IsNull() {
+		return bytesNull, nil
+	}
+
+	buf := make([]byte, 0, 64)
+	buf = append(buf, '"')
+	buf = self.AppendTo(buf)
+	buf = append(buf, '"')
+	return buf, nil
+}
+
+/*
+Implement `json.Unmarshaler`. If the input is empty or represents JSON `null`,
+zeroes the receiver. Otherwise parses a JSON string, using the same algorithm
+as `.Parse`.
+*/
+func (self *NullPrefix) UnmarshalJSON(src []byte) error {
+	if isJsonEmpty(src) {
+		self.Zero()
+		return nil
+	}
+	if isJsonStr(src) {
+		return self.UnmarshalText(cutJsonStr(src))
+	}
+	return errJsonString(src, self)
+}
+
+// Implement `driver.Valuer`, using `.Get`.
+func (self NullPrefix) Value() (driver.Value, error) {
+	return self.Get(), nil
+}
+
+/*
+Implement `sql.Scanner`, converting an arbitrary input to `gt.NullPrefix` and
+modifying the receiver. Acceptable inputs:
+
+  - `nil`           -> use `.Zero`
+  - `string`        -> use `.Parse`
+  - `[]byte`        -> use `.UnmarshalText`
+  - `netip.Prefix`  -> assign
+  - `*net.IPNet`    -> convert and assign
+  - `netip.Addr`    -> convert to single-address prefix
+  - `gt.NullPrefix` -> assign
+  - `gt.NullAddr`   -> convert to single-address prefix
+  - `gt.Getter`     -> scan underlying value
+*/
+func (self *NullPrefix) Scan(src any) error {
+	switch src := src.(type) {
+	case nil:
+		self.Zero()
+		return nil
+
+	case string:
+		return self.Parse(src)
+
+	case []byte:
+		return self.UnmarshalText(src)
+
+	case netip.Prefix:
+		*self = NullPrefix(src)
+		return nil
+
+	case *net.IPNet:
+		if src == nil {
+			self.Zero()
+			return nil
+		}
+		addr, ok := netip.AddrFromSlice(src.IP)
+		ones, total := src.Mask.Size()
+		if !ok || total == 0 {
+			return errScanType(self, src)
+		}
+		if total == 32 {
+			addr = addr.Unmap()
+		}
+		*self = NullPrefix(netip.PrefixFrom(addr, ones))
+		return nil
+
+	case netip.Addr:
+		return self.Scan(NullAddr(src))
+
+	case NullPrefix:
+		*self = src
+		return nil
+
+	case NullAddr:
+		if src.Zone() != `` {
+			return fmt.Errorf(`[gt] unable to convert %q to IP prefix: %w`, src.String(), errPrefixZone)
+		}
+		*self = src.NullPrefix()
+		return nil
+
+	default:
+		val, ok := get(src)
+		if ok {
+			return self.Scan(val)
+		}
+		return errScanType(self, src)
+	}
+}
+
+// Free cast to `netip.Prefix`.
+func (self NullPrefix) Prefix() netip.Prefix { return netip.Prefix(self) }
+
+// Returns the address, including host bits. If zero, returns zero.
+func (self NullPrefix) Addr() NullAddr { return NullAddr(self.Prefix().Addr()) }
+
+// Returns the prefix length in bits. If zero, returns -1.
+func (self NullPrefix) Bits() int { return self.Prefix().Bits() }
+
+/*
+Returns the prefix with host bits zeroed, such as "192.168.0.0/24" for
+"192.168.0.1/24". This is the form required by Postgres `cidr`.
+*/
+func (self NullPrefix) Masked() NullPrefix {
+	if self.IsNull() {
+		return self
+	}
+	return NullPrefix(self.Prefix().Masked())
+}
+
+// True if the prefix contains exactly one address, such as "192.168.0.1/32".
+func (self NullPrefix) IsSingleIP() bool { return self.Prefix().IsSingleIP() }
+
+/*
+True if the network of this prefix contains the given address, ignoring host
+bits of the prefix. Equivalent to the Postgres operator `>>=` for `inet`. False
+if either is zero.
+*/
+func (self NullPrefix) Contains(val NullAddr) bool {
+	return self.Prefix().Contains(val.Addr().WithZone(``))
+}
+
+/*
+True if the network of this prefix contains the entire network of the given
+prefix. Equivalent to the Postgres operator `>>=` for `cidr`. False if either
+is zero.
+*/
+func (self NullPrefix) ContainsPrefix(val NullPrefix) bool {
+	if self.IsNull() || val.IsNull() {
+		return false
+	}
+	return self.Bits() <= val.Bits() && self.Contains(val.Masked().Addr())
+}
+
+// True if the networks of both prefixes share any address. False if either is zero.
+func (self NullPrefix) Overlaps(val NullPrefix) bool {
+	return self.Prefix().Overlaps(val.Prefix())
+}
@@ -0,0 +1,210 @@
+package gt
+
+import "database/sql/driver"
+
+/*
+Shortcut: parses successfully or panics. Should be used only in root scope. When
+error handling is relevant, use `.Parse`.
+*/
+func ParseNullSemver(src string) (val NullSemver) {
+	try(val.Parse(src))
+	return
+}
+
+// Simplified constructor for a version without pre-release or build metadata.
+func NullSemverFrom(major, minor, patch uint64) NullSemver {
+	return NullSemver(SemverFrom(major, minor, patch))
+}
+
+/*
+Variant of `gt.Semver` where zero value is considered empty in text, and null
+in JSON and SQL. Note that the version "0.0.0" is the zero value, and is
+therefore encoded as empty or null.
+*/
+type NullSemver Semver
+
+var (
+	_ = Encodable(NullSemver{})
+	_ = Decodable((*NullSemver)(nil))
+)
+
+// Implement `gt.Zeroable`. Equivalent to `reflect.ValueOf(self).IsZero()`.
+func (self NullSemver) IsZero() bool { return Semver(self).IsZero() }
+
+// Implement `gt.Nullable`. True if zero.
+func (self NullSemver) IsNull() bool { return self.IsZero() }
+
+/*
+Implement `gt.Getter`. If zero, returns `nil`, otherwise uses `.String` to
+return a string representation.
+*/
+func (self NullSemver) Get() any {
+	if self.IsNull() {
+		return nil
+	}
+	return Semver(self).Get()
+}
+
+// Implement `gt.Setter`, using `.Scan`. Panics on error.
+func (self *NullSemver) Set(src any) { try(self.Scan(src)) }
+
+// Implement `gt.Zeroer`, zeroing the receiver.
+func (self *NullSemver) Zero() { (*Semver)(self).Zero() }
+
+/*
+Implement `fmt.Stringer`. If zero, returns an empty string. Otherwise returns a
+text representation in the standard format.
+*/
+func (self NullSemver) String() string {
+	if self.IsNull() {
+		return ``
+	}
+	return Semver(self).String()
+}
+
+/*
+Implement `gt.Parser`. If the input is empty, zeroes the receiver. Otherwise
+requires a valid SemVer 2.0.0 representation.
+*/
+func (self *NullSemver) Parse(src string) error {
+	if len(src) <= 0 {
+		self.Zero()
+		return nil
+	}
+	return (*Semver)(self).Parse(src)
+}
+
+// Implement `gt.AppenderTo`, using the same representation as `.String`.
+func (self NullSemver) AppendTo(buf []byte) []byte {
+	if self.IsNull() {
+		return buf
+	}
+	return Semver(self).AppendTo(buf)
+}
+
+/*
+Implement `encoding.TextMarhaler`. If zero, returns nil. Otherwise returns the
+same representation as `.String`.
+*/
+func (self NullSemver) MarshalText() ([]byte, error) {
+	if self.IsNull() {
+		return nil, nil
+	}
+	return Semver(self).MarshalText()
+}
+
+// Implement `encoding.TextUnmarshaler`, using the same algorithm as `.Parse`.
+func (self *NullSemver) UnmarshalText(src []byte) error {
+	if len(src) <= 0 {
+		self.Zero()
+		return nil
+	}
+	return (*Semver)(self).UnmarshalText(src)
+}
+
+/*
+Implement `json.Marshaler`. If zero, returns bytes representing `null`.
+Otherwise returns bytes representing a JSON string with the same text as in
+`.String`.
+*/
+func (self NullSemver) MarshalJSON() ([]byte, error) {
+	if self.IsNull() {
+		return bytesNull, nil
+	}
+	return Semver(self).MarshalJSON()
+}
+
+/*
+Implement `json.Unmarshaler`. If the input is empty or represents JSON `null`,
+zeroes the receiver. Otherwise parses a JSON string, using the same algorithm
+as `.Parse`.
+*/
+func (self *NullSemver) UnmarshalJSON(src []byte) error {
+	if isJsonEmpty(src) {
+		self.Zero()
+		return nil
+	}
+
+	if isJsonStr(src) {
+		return self.UnmarshalText(cutJsonStr(src))
+	}
+
+	return errJsonString(src, self)
+}
+
+// Implement `driver.Valuer`, using `.Get`.
+func (self NullSemver) Value() (driver.Value, error) {
+	return self.Get(), nil
+}
+
+/*
+Implement `sql.Scanner`, converting an arbitrary input to `gt.NullSemver` and
+modifying the receiver. Acceptable inputs:
+
+  - `nil`           -> use `.Zero`
+  - `string`        -> use `.Parse`
+  - `[]byte`        -> use `.UnmarshalText`
+  - `gt.Semver`     -> assign
+  - `*gt.Semver`    -> use `.Zero` or assign
+  - `gt.NullSemver` -> assign
+  - `gt.Getter`     -> scan underlying value
+*/
+func (self *NullSemver) Scan(src any) error {
+	switch src := src.(type) {
+	case nil:
+		self.Zero()
+		return nil
+
+	case string:
+		return self.Parse(src)
+
+	case []byte:
+		return self.UnmarshalText(src)
+
+	case Semver:
+		*self = NullSemver(src)
+		return nil
+
+	case *Semver:
+		if src == nil {
+			self.Zero()
+		} else {
+			*self = NullSemver(*src)
+		}
+		return nil
+
+	case NullSemver:
+		*self = src
+		return nil
+
+	default:
+		val, ok := get(src)
+		if ok {
+			return self.Scan(val)
+		}
+		return errScanType(self, src)
+	}
+}
+
+// Free cast to `gt.Semver`.
+func (self NullSemver) Semver() Semver { return Semver(self) }
+
+/*
+Same as `gt.Semver.Compare`. Zero is treated as "0.0.0", which is lower than
+any other version except its pre-releases.
+*/
+func (self NullSemver) Compare(val NullSemver) int {
+	return Semver(self).Compare(Semver(val))
+}
+
+// Same as `gt.Semver.Less`.
+func (self NullSemver) Less(val NullSemver) bool { return self.Compare(val) < 0 }
+
+// Same as `gt.Semver.LessOrEqual`.
+func (self NullSemver) LessOrEqual(val NullSemver) bool { return self.Compare(val) <= 0 }
+
+// Same as `gt.Semver.Equal`.
+func (self NullSemver) Equal(val NullSemver) bool { return self.Compare(val) == 0 }
+
+// Same as `gt.Semver.IsPre`.
+func (self NullSemver) IsPre() bool { return Semver(self).IsPre() }
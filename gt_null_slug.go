@@ -0,0 +1,259 @@
+package gt
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"strings"
+	"unicode/utf8"
+)
+
+/*
+Shortcut: parses successfully or panics. Should be used only in root scope. When
+error handling is relevant, use `.Parse`.
+*/
+func ParseNullSlug(src string) (val NullSlug) {
+	try(val.Parse(src))
+	return
+}
+
+/*
+Converts arbitrary text to a slug: letters are lowercased, apostrophes are
+removed, and every run of other characters becomes a single hyphen. Only ASCII
+letters and digits are kept; other characters, including accented letters, act
+as separators. May return zero if the input contains no ASCII letters or
+digits.
+
+	gt.Slugify(`Hello, World!`) == `hello-world`
+	gt.Slugify(`Don't Panic`)   == `dont-panic`
+*/
+func Slugify(src string) NullSlug {
+	buf := make([]byte, 0, len(src))
+	sep := false
+
+	for _, char := range src {
+		if char == '\'' || char == '’' {
+			continue
+		}
+
+		// Checked before lowercasing because some non-ASCII characters, such as
+		// the Kelvin sign, are lowercased to ASCII letters.
+		if char >= utf8.RuneSelf {
+			sep = true
+			continue
+		}
+
+		if char >= 'A' && char <= 'Z' {
+			char += 'a' - 'A'
+		}
+		if !charsetSlug.has(byte(char)) {
+			sep = true
+			continue
+		}
+
+		if sep && len(buf) > 0 {
+			buf = append(buf, '-')
+		}
+		sep = false
+		buf = append(buf, byte(char))
+	}
+	return NullSlug(buf)
+}
+
+/*
+Variant of `gt.NullString` for URL slugs, such as "hello-world": one or more
+groups of lowercase ASCII letters and digits, separated by single hyphens.
+Decoding trims surrounding whitespace and lowercases ASCII letters, then
+validates the result, rejecting anything else. This makes comparison of decoded
+slugs case-insensitive. To convert arbitrary text to a slug, use `gt.Slugify`.
+
+Just like `gt.NullString`, zero value is considered empty in text, and null in
+JSON and SQL.
+*/
+type NullSlug string
+
+var (
+	_ = Encodable(NullSlug(``))
+	_ = Decodable((*NullSlug)(nil))
+)
+
+// Implement `gt.Zeroable`. True if empty.
+func (self NullSlug) IsZero() bool { return self == `` }
+
+// Implement `gt.Nullable`. True if empty.
+func (self NullSlug) IsNull() bool { return self.IsZero() }
+
+// Implement `gt.Getter`. If zero, returns `nil`, otherwise returns `string`.
+func (self NullSlug) Get() any {
+	if self.IsNull() {
+		return nil
+	}
+	return string(self)
+}
+
+// Implement `gt.Setter`, using `.Scan`. Panics on error.
+func (self *NullSlug) Set(src any) { try(self.Scan(src)) }
+
+// Implement `gt.Zeroer`, zeroing the receiver.
+func (self *NullSlug) Zero() {
+	if self != nil {
+		*self = ``
+	}
+}
+
+// Implement `fmt.Stringer`, returning the string as-is.
+func (self NullSlug) String() string { return string(self) }
+
+/*
+Implement `gt.Parser`. If the input is empty or whitespace, zeroes the
+receiver. Otherwise trims whitespace, lowercases ASCII letters, and validates
+the result.
+*/
+func (self *NullSlug) Parse(src string) (err error) {
+	val := strings.TrimSpace(src)
+	if len(val) <= 0 {
+		self.Zero()
+		return nil
+	}
+
+	defer errParse(&err, src, `slug`)
+
+	// Checked before lowercasing because some non-ASCII characters, such as
+	// the Kelvin sign, are lowercased to ASCII letters.
+	if !isAscii(val) {
+		return errSlugFormat
+	}
+
+	val = strings.ToLower(val)
+	if !isSlug(val) {
+		return errSlugFormat
+	}
+
+	*self = NullSlug(val)
+	return nil
+}
+
+// Implement `gt.AppenderTo`, appending the string as-is.
+func (self NullSlug) AppendTo(buf []byte) []byte { return append(buf, self...) }
+
+/*
+Implement `encoding.TextMarhaler`. If zero, returns nil. Otherwise returns the
+string as-is.
+*/
+func (self NullSlug) MarshalText() ([]byte, error) {
+	if self.IsNull() {
+		return nil, nil
+	}
+	return self.AppendTo(nil), nil
+}
+
+// Implement `encoding.TextUnmarshaler`, using the same algorithm as `.Parse`.
+func (self *NullSlug) UnmarshalText(src []byte) error {
+	return self.Parse(string(src))
+}
+
+/*
+Implement `json.Marshaler`. If zero, returns bytes representing `null`.
+Otherwise returns bytes representing a JSON string with the same text as in
+`.String`.
+*/
+func (self NullSlug) MarshalJSON() ([]byte, error) {
+	if self.IsNull() {
+		return bytesNull, nil
+	}
+	return json.Marshal(string(self))
+}
+
+/*
+Implement `json.Unmarshaler`. If the input is empty or represents JSON `null`,
+zeroes the receiver. Otherwise decodes a JSON string and uses `.Parse`.
+*/
+func (self *NullSlug) UnmarshalJSON(src []byte) error {
+	if isJsonEmpty(src) {
+		self.Zero()
+		return nil
+	}
+	if !isJsonStr(src) {
+		return errJsonString(src, self)
+	}
+
+	var val string
+	err := json.Unmarshal(src, &val)
+	if err != nil {
+		return err
+	}
+	return self.Parse(val)
+}
+
+// Implement `driver.Valuer`, using `.Get`.
+func (self NullSlug) Value() (driver.Value, error) { return self.Get(), nil }
+
+/*
+Implement `sql.Scanner`, converting an arbitrary input to `gt.NullSlug` and
+modifying the receiver. Postgres `citext` is scanned like any other text. All
+inputs other than `gt.NullSlug` are validated. Acceptable inputs:
+
+  - `nil`               -> use `.Zero`
+  - `string`            -> use `.Parse`
+  - `[]byte`            -> use `.UnmarshalText`
+  - `gt.NullSlug`       -> assign
+  - `gt.NullString`     -> use `.Parse`
+  - `gt.NullCiString`   -> use `.Parse`
+  - `gt.NullTrimString` -> use `.Parse`
+  - `gt.Getter`         -> scan underlying value
+*/
+func (self *NullSlug) Scan(src any) error {
+	switch src := src.(type) {
+	case nil:
+		self.Zero()
+		return nil
+
+	case string:
+		return self.Parse(src)
+
+	case []byte:
+		return self.UnmarshalText(src)
+
+	case NullSlug:
+		*self = src
+		return nil
+
+	case NullString:
+		return self.Parse(string(src))
+
+	case NullCiString:
+		return self.Parse(string(src))
+
+	case NullTrimString:
+		return self.Parse(string(src))
+
+	default:
+		val, ok := get(src)
+		if ok {
+			return self.Scan(val)
+		}
+		return errScanType(self, src)
+	}
+}
+
+// Same as `len(self)`.
+func (self NullSlug) Len() int { return len(self) }
+
+var charsetSlug = new(charset).add(`0123456789abcdefghijklmnopqrstuvwxyz`)
+
+func isSlug(src string) bool {
+	if len(src) <= 0 || src[0] == '-' || src[len(src)-1] == '-' {
+		return false
+	}
+
+	for ind := 0; ind < len(src); ind++ {
+		char := src[ind]
+		if char == '-' {
+			if src[ind-1] == '-' {
+				return false
+			}
+		} else if !charsetSlug.has(char) {
+			return false
+		}
+	}
+	return true
+}
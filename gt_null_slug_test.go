@@ -0,0 +1,113 @@
+package gt_test
+
+import (
+	"testing"
+
+	"github.com/mitranim/gt"
+)
+
+func TestNullSlug_common(t *testing.T) {
+	var (
+		primZero    = any(nil)
+		primNonZero = `hello-world`
+		textZero    = ``
+		textNonZero = `hello-world`
+		jsonZero    = bytesNull
+		jsonNonZero = []byte(`"hello-world"`)
+		zero        = gt.NullSlug(``)
+		nonZero     = gt.NullSlug(`hello-world`)
+		dec         = new(gt.NullSlug)
+	)
+
+	eq(true, zero.IsNull())
+	eq(false, nonZero.IsNull())
+
+	testAny(t, primZero, primNonZero, textZero, textNonZero, jsonZero, jsonNonZero, zero, nonZero, dec)
+}
+
+func TestNullSlug(t *testing.T) {
+	t.Run(`Parse`, func(t *testing.T) {
+		test := func(exp, src string) {
+			t.Helper()
+			eq(gt.NullSlug(exp), gt.ParseNullSlug(src))
+		}
+
+		test(``, ``)
+		test(``, `  `)
+		test(`one`, `one`)
+		test(`one`, ` One `)
+		test(`one-two-3`, `one-two-3`)
+		test(`one-two`, `ONE-TWO`)
+		test(`2024`, `2024`)
+	})
+
+	t.Run(`invalid`, func(t *testing.T) {
+		test := func(src string) {
+			t.Helper()
+
+			val := gt.NullSlug(`prev`)
+			fail(val.Parse(src))
+			fail(val.Scan(src))
+			fail(val.Scan([]byte(src)))
+			fail(val.UnmarshalJSON(jsonBytes(src)))
+			eq(gt.NullSlug(`prev`), val)
+		}
+
+		test(`-one`)
+		test(`one-`)
+		test(`-`)
+		test(`one--two`)
+		test(`one two`)
+		test(`one_two`)
+		test(`one.two`)
+		test(`café`)
+		test("\u212a")
+
+		panics(t, `unable to parse "one two" into slug: expected lowercase letters and digits separated by single hyphens`, func() {
+			gt.ParseNullSlug(`one two`)
+		})
+
+		var val gt.NullSlug
+		fail(val.UnmarshalJSON([]byte(`10`)))
+		fail(val.Scan(10))
+	})
+
+	t.Run(`Scan`, func(t *testing.T) {
+		var val gt.NullSlug
+
+		try(val.Scan(gt.NullString(`One`)))
+		eq(gt.NullSlug(`one`), val)
+
+		try(val.Scan(gt.NullCiString(`Two`)))
+		eq(gt.NullSlug(`two`), val)
+
+		try(val.Scan(gt.NullTrimString(`three`)))
+		eq(gt.NullSlug(`three`), val)
+
+		fail(val.Scan(gt.NullString(`four five`)))
+	})
+
+	t.Run(`Slugify`, func(t *testing.T) {
+		test := func(exp, src string) {
+			t.Helper()
+			out := gt.Slugify(src)
+			eq(gt.NullSlug(exp), out)
+			eq(out, gt.ParseNullSlug(out.String()))
+		}
+
+		test(``, ``)
+		test(``, ` -- `)
+		test(``, `!!!`)
+		test(`hello-world`, `Hello, World!`)
+		test(`hello-world`, `  hello   world  `)
+		test(`dont-panic`, `Don't Panic`)
+		test(`dont-panic`, `Don’t Panic`)
+		test(`one-two-three`, `one_two.three`)
+		test(`one-two`, `--one--two--`)
+		test(`caf`, `Café`)
+		test(`top-10-lists`, `Top 10 Lists`)
+		test(``, "\u212a")
+		test(`elvin`, "\u212aelvin")
+		test(`stanbul`, "\u0130stanbul")
+	})
+}
@@ -128,11 +128,17 @@ func (self NullTime) IsNull() bool { return self.IsZero() }
 // Implement `gt.PtrGetter`, returning `*time.Time`.
 func (self *NullTime) GetPtr() any { return self.TimePtr() }
 
-// Implement `gt.Getter`. If zero, returns `nil`, otherwise returns `time.Time`.
+/*
+Implement `gt.Getter`. If zero, returns `nil`. If infinite, returns a string
+understood by Postgres. Otherwise returns `time.Time`.
+*/
 func (self NullTime) Get() any {
 	if self.IsNull() {
 		return nil
 	}
+	if self.IsInfinite() {
+		return self.String()
+	}
 	return self.Time()
 }
 
@@ -154,13 +160,14 @@ func (self NullTime) String() string {
 	if self.IsNull() {
 		return ``
 	}
-	return self.Time().Format(timeFormat)
+	return bytesString(self.AppendTo(make([]byte, 0, len(timeFormat))))
 }
 
 /*
 Implement `gt.Parser`. If the input is empty, zeroes the receiver. Otherwise
 requires either an RFC3339 timestamp (default time parsing format in Go),
-or a numeric timestamp in milliseconds.
+a numeric timestamp in milliseconds, or "infinity" / "-infinity"
+(case-insensitive, like in Postgres).
 */
 func (self *NullTime) Parse(src string) error {
 	if len(src) <= 0 {
@@ -168,27 +175,52 @@ func (self *NullTime) Parse(src string) error {
 		return nil
 	}
 
+	if self.parseInfinity(src) {
+		return nil
+	}
+
 	if isIntString(src) {
 		milli, err := strconv.ParseInt(src, 10, 64)
 		*self = NullTime(time.UnixMilli(milli).In(time.UTC))
 		return err
 	}
 
-	val, err := time.Parse(timeFormat, src)
-	if err != nil {
-		return err
+	val, ok := parseTimeFast(src)
+	if !ok {
+		var err error
+		val, err = time.Parse(timeFormat, src)
+		if err != nil {
+			return err
+		}
 	}
 
 	*self = NullTime(val)
 	return nil
 }
 
+/*
+Variant of `.Parse` that uses the given `gt.TimeParser`, allowing non-strict
+formats such as RFC 1123 or Postgres `timestamp`. On error, the receiver is
+unchanged.
+*/
+func (self *NullTime) ParseWith(src string, parser TimeParser) error {
+	val, err := parser.ParseNullTime(src)
+	if err != nil {
+		return err
+	}
+	*self = val
+	return nil
+}
+
 // Implement `gt.AppenderTo`, using the same representation as `.String`.
 func (self NullTime) AppendTo(buf []byte) []byte {
 	if self.IsNull() {
 		return buf
 	}
-	return self.Time().AppendFormat(buf, timeFormat)
+	if sign := self.infinitySign(); sign != 0 {
+		return append(buf, infinityString(sign)...)
+	}
+	return appendTimeFast(buf, self.Time())
 }
 
 /*
@@ -208,13 +240,18 @@ func (self *NullTime) UnmarshalText(src []byte) error {
 }
 
 /*
-Implement `json.Marshaler`. If zero, returns bytes representing `null`.
-Otherwise uses the default `json.Marshal` behavior for `time.Time`.
+Implement `json.Marshaler`. If zero, returns bytes representing `null`. If
+infinite, returns "infinity" or "-infinity" as a JSON string; for other modes,
+see `gt.InfinityJson`. Otherwise uses the default `json.Marshal` behavior for
+`time.Time`.
 */
 func (self NullTime) MarshalJSON() ([]byte, error) {
 	if self.IsNull() {
 		return bytesNull, nil
 	}
+	if sign := self.infinitySign(); sign != 0 {
+		return InfinityJsonString.json(sign, timeInfinityBound), nil
+	}
 
 	buf := make([]byte, 0, len(timeFormat)+2)
 	buf = append(buf, '"')
@@ -226,13 +263,25 @@ func (self NullTime) MarshalJSON() ([]byte, error) {
 /*
 Implement `json.Unmarshaler`. If the input is empty or represents JSON `null`,
 zeroes the receiver. Otherwise uses the default `json.Unmarshal` behavior
-for `*time.Time`.
+for `*time.Time`, with a fast path for common RFC3339 strings.
 */
 func (self *NullTime) UnmarshalJSON(src []byte) error {
 	if isJsonEmpty(src) {
 		self.Zero()
 		return nil
 	}
+
+	if isJsonStr(src) {
+		str := bytesString(cutJsonStr(src))
+		val, ok := parseTimeFast(str)
+		if ok {
+			*self = NullTime(val)
+			return nil
+		}
+		if self.parseInfinity(str) {
+			return nil
+		}
+	}
 	return json.Unmarshal(src, self.GetPtr())
 }
 
@@ -301,6 +350,13 @@ UTC, the resulting code is valid. For non-UTC, the resulting code is invalid,
 because `*time.Location` doesn't implement `fmt.GoStringer`.
 */
 func (self NullTime) GoString() string {
+	switch self.infinitySign() {
+	case 1:
+		return `gt.NullTimeInfinity`
+	case -1:
+		return `gt.NullTimeNegInfinity`
+	}
+
 	year, month, day := self.Date()
 	hour, min, sec, nsec := self.Hour(), self.Minute(), self.Second(), self.Nanosecond()
 	loc := self.Location()
@@ -332,14 +388,25 @@ func (self NullTime) MaybeTime() *time.Time {
 	return self.TimePtr()
 }
 
-// Shortcut for `gt.NullDateFrom(self.Date())`.
+/*
+Shortcut for `gt.NullDateFrom(self.Date())`. Infinite timestamps are converted
+to infinite dates.
+*/
 func (self NullTime) NullDate() NullDate {
-	return NullDateFrom(self.Date())
+	switch self.infinitySign() {
+	case 1:
+		return NullDateInfinity
+	case -1:
+		return NullDateNegInfinity
+	default:
+		return NullDateFrom(self.Date())
+	}
 }
 
 /*
 Adds the interval to the time, returning the modified time. If the interval is a
-zero value, the resulting time should be identical to the source.
+zero value, the resulting time should be identical to the source. Infinity
+remains unchanged.
 */
 func (self NullTime) AddInterval(val Interval) NullTime {
 	return self.AddDate(val.Date()).Add(val.OnlyTime().Duration())
@@ -424,14 +491,45 @@ func (self NullTime) Nanosecond() int { return self.Time().Nanosecond() }
 // `gt.NullTime` version of `time.Time.YearDay`.
 func (self NullTime) YearDay() int { return self.Time().YearDay() }
 
-// `gt.NullTime` version of `time.Time.Add`.
-func (self NullTime) Add(val time.Duration) NullTime { return NullTime(self.Time().Add(val)) }
+// `gt.NullTime` version of `time.Time.Add`. Infinity remains unchanged.
+func (self NullTime) Add(val time.Duration) NullTime {
+	if self.IsInfinite() {
+		return self
+	}
+	return NullTime(self.Time().Add(val))
+}
 
 // `gt.NullTime` version of `time.Time.Sub`.
 func (self NullTime) Sub(val NullTime) time.Duration { return self.Time().Sub(val.Time()) }
 
-// `gt.NullTime` version of `time.Time.AddDate`.
-func (self NullTime) AddDate(y, m, d int) NullTime { return NullTime(self.Time().AddDate(y, m, d)) }
+// `gt.NullTime` version of `time.Time.AddDate`. Infinity remains unchanged.
+func (self NullTime) AddDate(y, m, d int) NullTime {
+	if self.IsInfinite() {
+		return self
+	}
+	return NullTime(self.Time().AddDate(y, m, d))
+}
+
+/*
+True if the timestamp is `gt.NullTimeInfinity` or `gt.NullTimeNegInfinity`,
+corresponding to Postgres `infinity` and `-infinity`.
+*/
+func (self NullTime) IsInfinite() bool { return self.infinitySign() != 0 }
+
+func (self NullTime) infinitySign() int { return timeInfinitySign(self.Time()) }
+
+func (self *NullTime) parseInfinity(src string) bool {
+	switch infinitySign(src) {
+	case 1:
+		*self = NullTimeInfinity
+		return true
+	case -1:
+		*self = NullTimeNegInfinity
+		return true
+	default:
+		return false
+	}
+}
 
 // `gt.NullTime` version of `time.Time.UTC`.
 func (self NullTime) UTC() NullTime { return NullTime(self.Time().UTC()) }
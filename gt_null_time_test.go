@@ -115,3 +115,91 @@ func TestNullTimeLess(t *testing.T) {
 	test(false, gt.NullDateUTC(1, 1, 2), gt.NullDateUTC(1, 1, 3), gt.NullTime{})
 	test(true, gt.NullTime{}, gt.NullDateUTC(1, 1, 3), gt.NullDateUTC(1, 1, 4))
 }
+
+// Verifies that the fast path in `gt.NullTime` is indistinguishable from
+// `time.Parse` and `time.Time.AppendFormat`.
+func TestNullTime_rfc3339(t *testing.T) {
+	loc, err := time.LoadLocation(`Europe/Berlin`)
+	try(err)
+
+	srcs := []string{
+		`1234-05-06T07:08:09Z`,
+		`2024-05-01T10:20:30.1Z`,
+		`2024-05-01T10:20:30.123456789Z`,
+		`2024-05-01T10:20:30.1234567891Z`,
+		`2024-05-01T10:20:30,123Z`,
+		`2024-05-01T10:20:30+00:00`,
+		`2024-05-01T10:20:30-00:00`,
+		`2024-05-01T10:20:30+02:00`,
+		`2024-01-01T10:20:30+01:00`,
+		`2024-05-01T10:20:30-05:30`,
+		`2024-05-01T10:20:30.5+14:00`,
+		`2024-02-29T00:00:00Z`,
+		`0000-01-01T00:00:00Z`,
+		`9999-12-31T23:59:59.999999999Z`,
+		`2023-02-29T00:00:00Z`,
+		`2024-13-01T00:00:00Z`,
+		`2024-05-01T24:00:00Z`,
+		`2024-05-01T10:60:00Z`,
+		`2024-05-01T10:20:60Z`,
+		`2024-05-01T10:20:30+24:00`,
+		`2024-05-01T10:20:30+02:60`,
+		`2024-05-01t10:20:30z`,
+		`2024-05-01T10:20:30.Z`,
+		`2024-05-01T10:20:30`,
+		`2024-05-01T10:20:30+0200`,
+		`2024-05-01T10:20:30ZZ`,
+		`2024-05-01 10:20:30Z`,
+		`2024-5-01T10:20:30Z`,
+	}
+
+	test := func(src string) {
+		t.Helper()
+
+		exp, expErr := time.Parse(time.RFC3339Nano, src)
+
+		var val gt.NullTime
+		err := val.Parse(src)
+		eq(expErr, err)
+		if err != nil {
+			return
+		}
+		eq(exp, val.Time())
+		eq(exp.Format(time.RFC3339Nano), val.String())
+
+		var jsonVal gt.NullTime
+		try(jsonVal.UnmarshalJSON(jsonBytes(src)))
+
+		var jsonExp time.Time
+		try(jsonExp.UnmarshalJSON(jsonBytes(src)))
+		eq(jsonExp, jsonVal.Time())
+	}
+
+	for _, src := range srcs {
+		test(src)
+	}
+
+	prev := time.Local
+	time.Local = loc
+	defer func() { time.Local = prev }()
+
+	for _, src := range srcs {
+		test(src)
+	}
+
+	t.Run(`AppendTo`, func(t *testing.T) {
+		test := func(val time.Time) {
+			t.Helper()
+			eq(val.Format(time.RFC3339Nano), gt.NullTime(val).String())
+		}
+
+		test(time.Date(2024, 5, 1, 10, 20, 30, 0, time.UTC))
+		test(time.Date(2024, 5, 1, 10, 20, 30, 100, time.UTC))
+		test(time.Date(2024, 5, 1, 10, 20, 30, 120000000, loc))
+		test(time.Date(2024, 5, 1, 10, 20, 30, 0, time.FixedZone(``, -(5*3600+30*60))))
+		test(time.Date(2024, 5, 1, 10, 20, 30, 0, time.FixedZone(``, 45)))
+		test(time.Date(-1, 5, 1, 10, 20, 30, 0, time.UTC))
+		test(time.Date(10000, 5, 1, 10, 20, 30, 0, time.UTC))
+		test(time.Date(1, 1, 1, 0, 0, 0, 1, time.UTC))
+	})
+}
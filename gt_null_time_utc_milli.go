@@ -0,0 +1,118 @@
+package gt
+
+import (
+	"database/sql/driver"
+	"time"
+)
+
+/*
+Shortcut: parses successfully or panics. Should be used only in root scope. When
+error handling is relevant, use `.Parse`.
+*/
+func ParseNullTimeUtcMilli(src string) (val NullTimeUtcMilli) {
+	try(val.Parse(src))
+	return
+}
+
+/*
+Variant of `gt.NullTime` that always encodes in UTC with millisecond precision,
+such as "2024-05-01T10:20:30.120Z", using `gt.TimeEncoder` with `UTC: true` and
+`Precision: time.Millisecond`. Useful for APIs where clients compare timestamps
+as strings. Decoding is identical to `gt.NullTime`; the value is truncated only
+on encoding. Can be freely cast to and from `gt.NullTime`.
+*/
+type NullTimeUtcMilli NullTime
+
+var (
+	_ = Encodable(NullTimeUtcMilli{})
+	_ = Decodable((*NullTimeUtcMilli)(nil))
+)
+
+// Implement `gt.Zeroable`. Same as `self.Time().IsZero()`.
+func (self NullTimeUtcMilli) IsZero() bool { return self.NullTime().IsZero() }
+
+// Implement `gt.Nullable`. True if zero.
+func (self NullTimeUtcMilli) IsNull() bool { return self.IsZero() }
+
+// Implement `gt.PtrGetter`, returning `*time.Time`.
+func (self *NullTimeUtcMilli) GetPtr() any { return (*time.Time)(self) }
+
+/*
+Implement `gt.Getter`. If zero, returns `nil`, otherwise returns `time.Time`
+converted to UTC and truncated to millisecond.
+*/
+func (self NullTimeUtcMilli) Get() any { return timeEncoderUtcMilli.Time(self.NullTime()).Get() }
+
+// Implement `gt.Setter`, using `.Scan`. Panics on error.
+func (self *NullTimeUtcMilli) Set(src any) { try(self.Scan(src)) }
+
+// Implement `gt.Zeroer`, zeroing the receiver.
+func (self *NullTimeUtcMilli) Zero() { (*NullTime)(self).Zero() }
+
+/*
+Implement `fmt.Stringer`. If zero, returns an empty string. Otherwise returns a
+text representation in the RFC 3339 format in UTC, with millisecond precision.
+*/
+func (self NullTimeUtcMilli) String() string { return timeEncoderUtcMilli.String(self.NullTime()) }
+
+// Implement `gt.Parser`, using `gt.NullTime.Parse`.
+func (self *NullTimeUtcMilli) Parse(src string) error { return (*NullTime)(self).Parse(src) }
+
+// Implement `gt.AppenderTo`, using the same representation as `.String`.
+func (self NullTimeUtcMilli) AppendTo(buf []byte) []byte {
+	return timeEncoderUtcMilli.AppendTo(buf, self.NullTime())
+}
+
+/*
+Implement `encoding.TextMarhaler`. If zero, returns nil. Otherwise returns the
+same representation as `.String`.
+*/
+func (self NullTimeUtcMilli) MarshalText() ([]byte, error) {
+	return timeEncoderUtcMilli.Text(self.NullTime()), nil
+}
+
+// Implement `encoding.TextUnmarshaler`, using the same algorithm as `.Parse`.
+func (self *NullTimeUtcMilli) UnmarshalText(src []byte) error {
+	return self.Parse(bytesString(src))
+}
+
+/*
+Implement `json.Marshaler`. If zero, returns bytes representing `null`.
+Otherwise returns bytes representing a JSON string with the same text as in
+`.String`.
+*/
+func (self NullTimeUtcMilli) MarshalJSON() ([]byte, error) {
+	return timeEncoderUtcMilli.Json(self.NullTime()), nil
+}
+
+// Implement `json.Unmarshaler`, using `gt.NullTime.UnmarshalJSON`.
+func (self *NullTimeUtcMilli) UnmarshalJSON(src []byte) error {
+	return (*NullTime)(self).UnmarshalJSON(src)
+}
+
+// Implement `driver.Valuer`, using `.Get`.
+func (self NullTimeUtcMilli) Value() (driver.Value, error) {
+	return self.Get(), nil
+}
+
+/*
+Implement `sql.Scanner`, converting an arbitrary input to `gt.NullTimeUtcMilli` and
+modifying the receiver. Acceptable inputs:
+
+  - `gt.NullTimeUtcMilli` -> assign
+  - other                 -> use `gt.NullTime.Scan`
+*/
+func (self *NullTimeUtcMilli) Scan(src any) error {
+	val, ok := src.(NullTimeUtcMilli)
+	if ok {
+		*self = val
+		return nil
+	}
+	return (*NullTime)(self).Scan(src)
+}
+
+// Free cast to `gt.NullTime`.
+func (self NullTimeUtcMilli) NullTime() NullTime { return NullTime(self) }
+
+// Free cast to `time.Time`.
+func (self NullTimeUtcMilli) Time() time.Time { return time.Time(self) }
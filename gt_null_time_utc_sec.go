@@ -0,0 +1,118 @@
+package gt
+
+import (
+	"database/sql/driver"
+	"time"
+)
+
+/*
+Shortcut: parses successfully or panics. Should be used only in root scope. When
+error handling is relevant, use `.Parse`.
+*/
+func ParseNullTimeUtcSec(src string) (val NullTimeUtcSec) {
+	try(val.Parse(src))
+	return
+}
+
+/*
+Variant of `gt.NullTime` that always encodes in UTC with second precision, such
+as "2024-05-01T10:20:30Z", using `gt.TimeEncoder` with `UTC: true` and
+`Precision: time.Second`. Useful for APIs where clients compare timestamps as
+strings. Decoding is identical to `gt.NullTime`; the value is truncated only on
+encoding. Can be freely cast to and from `gt.NullTime`.
+*/
+type NullTimeUtcSec NullTime
+
+var (
+	_ = Encodable(NullTimeUtcSec{})
+	_ = Decodable((*NullTimeUtcSec)(nil))
+)
+
+// Implement `gt.Zeroable`. Same as `self.Time().IsZero()`.
+func (self NullTimeUtcSec) IsZero() bool { return self.NullTime().IsZero() }
+
+// Implement `gt.Nullable`. True if zero.
+func (self NullTimeUtcSec) IsNull() bool { return self.IsZero() }
+
+// Implement `gt.PtrGetter`, returning `*time.Time`.
+func (self *NullTimeUtcSec) GetPtr() any { return (*time.Time)(self) }
+
+/*
+Implement `gt.Getter`. If zero, returns `nil`, otherwise returns `time.Time`
+converted to UTC and truncated to second.
+*/
+func (self NullTimeUtcSec) Get() any { return timeEncoderUtcSec.Time(self.NullTime()).Get() }
+
+// Implement `gt.Setter`, using `.Scan`. Panics on error.
+func (self *NullTimeUtcSec) Set(src any) { try(self.Scan(src)) }
+
+// Implement `gt.Zeroer`, zeroing the receiver.
+func (self *NullTimeUtcSec) Zero() { (*NullTime)(self).Zero() }
+
+/*
+Implement `fmt.Stringer`. If zero, returns an empty string. Otherwise returns a
+text representation in the RFC 3339 format in UTC, with second precision.
+*/
+func (self NullTimeUtcSec) String() string { return timeEncoderUtcSec.String(self.NullTime()) }
+
+// Implement `gt.Parser`, using `gt.NullTime.Parse`.
+func (self *NullTimeUtcSec) Parse(src string) error { return (*NullTime)(self).Parse(src) }
+
+// Implement `gt.AppenderTo`, using the same representation as `.String`.
+func (self NullTimeUtcSec) AppendTo(buf []byte) []byte {
+	return timeEncoderUtcSec.AppendTo(buf, self.NullTime())
+}
+
+/*
+Implement `encoding.TextMarhaler`. If zero, returns nil. Otherwise returns the
+same representation as `.String`.
+*/
+func (self NullTimeUtcSec) MarshalText() ([]byte, error) {
+	return timeEncoderUtcSec.Text(self.NullTime()), nil
+}
+
+// Implement `encoding.TextUnmarshaler`, using the same algorithm as `.Parse`.
+func (self *NullTimeUtcSec) UnmarshalText(src []byte) error {
+	return self.Parse(bytesString(src))
+}
+
+/*
+Implement `json.Marshaler`. If zero, returns bytes representing `null`.
+Otherwise returns bytes representing a JSON string with the same text as in
+`.String`.
+*/
+func (self NullTimeUtcSec) MarshalJSON() ([]byte, error) {
+	return timeEncoderUtcSec.Json(self.NullTime()), nil
+}
+
+// Implement `json.Unmarshaler`, using `gt.NullTime.UnmarshalJSON`.
+func (self *NullTimeUtcSec) UnmarshalJSON(src []byte) error {
+	return (*NullTime)(self).UnmarshalJSON(src)
+}
+
+// Implement `driver.Valuer`, using `.Get`.
+func (self NullTimeUtcSec) Value() (driver.Value, error) {
+	return self.Get(), nil
+}
+
+/*
+Implement `sql.Scanner`, converting an arbitrary input to `gt.NullTimeUtcSec` and
+modifying the receiver. Acceptable inputs:
+
+  - `gt.NullTimeUtcSec` -> assign
+  - other               -> use `gt.NullTime.Scan`
+*/
+func (self *NullTimeUtcSec) Scan(src any) error {
+	val, ok := src.(NullTimeUtcSec)
+	if ok {
+		*self = val
+		return nil
+	}
+	return (*NullTime)(self).Scan(src)
+}
+
+// Free cast to `gt.NullTime`.
+func (self NullTimeUtcSec) NullTime() NullTime { return NullTime(self) }
+
+// Free cast to `time.Time`.
+func (self NullTimeUtcSec) Time() time.Time { return time.Time(self) }
@@ -0,0 +1,192 @@
+package gt
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+/*
+Variant of `gt.NullString` which normalizes whitespace when decoding: leading
+and trailing whitespace is removed, and every internal run of whitespace is
+replaced with a single space. Whitespace is defined by `unicode.IsSpace`. If
+nothing remains after trimming, the result is zero, which is considered empty
+in text, and null in JSON and SQL. Suitable for names, titles, and other
+user-entered text. Encoding returns the string as-is.
+
+All decoding methods normalize the input, including `.Scan`. Normalization can
+be bypassed only by direct type conversion, such as `gt.NullTrimString(str)`.
+*/
+type NullTrimString string
+
+var (
+	_ = Encodable(NullTrimString(``))
+	_ = Decodable((*NullTrimString)(nil))
+)
+
+// Implement `gt.Zeroable`. True if empty.
+func (self NullTrimString) IsZero() bool { return self == `` }
+
+// Implement `gt.Nullable`. True if empty.
+func (self NullTrimString) IsNull() bool { return self.IsZero() }
+
+// Implement `gt.Getter`. If zero, returns `nil`, otherwise returns `string`.
+func (self NullTrimString) Get() any {
+	if self.IsNull() {
+		return nil
+	}
+	return string(self)
+}
+
+// Implement `gt.Setter`, using `.Scan`. Panics on error.
+func (self *NullTrimString) Set(src any) { try(self.Scan(src)) }
+
+// Implement `gt.Zeroer`, zeroing the receiver.
+func (self *NullTrimString) Zero() {
+	if self != nil {
+		*self = ``
+	}
+}
+
+// Implement `fmt.Stringer`, returning the string as-is.
+func (self NullTrimString) String() string { return string(self) }
+
+/*
+Implement `gt.Parser`, trimming and collapsing whitespace. If nothing remains,
+zeroes the receiver. Never returns an error.
+*/
+func (self *NullTrimString) Parse(src string) error {
+	*self = NullTrimString(trimCollapse(src))
+	return nil
+}
+
+// Implement `gt.AppenderTo`, appending the string as-is.
+func (self NullTrimString) AppendTo(buf []byte) []byte { return append(buf, self...) }
+
+/*
+Implement `encoding.TextMarhaler`. If zero, returns nil. Otherwise returns the
+string as-is.
+*/
+func (self NullTrimString) MarshalText() ([]byte, error) {
+	if self.IsNull() {
+		return nil, nil
+	}
+	return self.AppendTo(nil), nil
+}
+
+// Implement `encoding.TextUnmarshaler`, using the same algorithm as `.Parse`.
+func (self *NullTrimString) UnmarshalText(src []byte) error {
+	return self.Parse(string(src))
+}
+
+/*
+Implement `json.Marshaler`. If zero, returns bytes representing `null`.
+Otherwise uses the default `json.Marshal` behavior for `string`.
+*/
+func (self NullTrimString) MarshalJSON() ([]byte, error) {
+	if self.IsNull() {
+		return bytesNull, nil
+	}
+	return json.Marshal(string(self))
+}
+
+/*
+Implement `json.Unmarshaler`. If the input is empty or represents JSON `null`,
+zeroes the receiver. Otherwise decodes a JSON string and uses `.Parse`.
+*/
+func (self *NullTrimString) UnmarshalJSON(src []byte) error {
+	if isJsonEmpty(src) {
+		self.Zero()
+		return nil
+	}
+
+	var val string
+	err := json.Unmarshal(src, &val)
+	if err != nil {
+		return err
+	}
+	return self.Parse(val)
+}
+
+// Implement `driver.Valuer`, using `.Get`.
+func (self NullTrimString) Value() (driver.Value, error) { return self.Get(), nil }
+
+/*
+Implement `sql.Scanner`, converting an arbitrary input to `gt.NullTrimString`
+and modifying the receiver. Postgres `citext` is scanned like any other text.
+Acceptable inputs:
+
+  - `nil`               -> use `.Zero`
+  - `string`            -> use `.Parse`
+  - `[]byte`            -> use `.UnmarshalText`
+  - `gt.NullTrimString` -> assign
+  - `gt.NullString`     -> use `.Parse`
+  - `gt.NullCiString`   -> use `.Parse`
+  - `gt.Getter`         -> scan underlying value
+*/
+func (self *NullTrimString) Scan(src any) error {
+	switch src := src.(type) {
+	case nil:
+		self.Zero()
+		return nil
+
+	case string:
+		return self.Parse(src)
+
+	case []byte:
+		return self.UnmarshalText(src)
+
+	case NullTrimString:
+		*self = src
+		return nil
+
+	case NullString:
+		return self.Parse(string(src))
+
+	case NullCiString:
+		return self.Parse(string(src))
+
+	default:
+		val, ok := get(src)
+		if ok {
+			return self.Scan(val)
+		}
+		return errScanType(self, src)
+	}
+}
+
+// Same as `len(self)`.
+func (self NullTrimString) Len() int { return len(self) }
+
+// Trims and collapses whitespace. Doesn't allocate if already normalized.
+func trimCollapse(src string) string {
+	if isTrimCollapsed(src) {
+		return src
+	}
+	return strings.Join(strings.Fields(src), ` `)
+}
+
+func isTrimCollapsed(src string) bool {
+	prevSpace := true
+
+	for ind := 0; ind < len(src); {
+		char, size := rune(src[ind]), 1
+		if char >= utf8.RuneSelf {
+			char, size = utf8.DecodeRuneInString(src[ind:])
+		}
+
+		if unicode.IsSpace(char) {
+			if prevSpace || char != ' ' {
+				return false
+			}
+			prevSpace = true
+		} else {
+			prevSpace = false
+		}
+		ind += size
+	}
+
+	return !prevSpace || len(src) <= 0
+}
@@ -0,0 +1,99 @@
+package gt_test
+
+import (
+	"testing"
+
+	"github.com/mitranim/gt"
+)
+
+func TestNullTrimString_common(t *testing.T) {
+	var (
+		primZero    = any(nil)
+		primNonZero = `one two`
+		textZero    = ``
+		textNonZero = `one two`
+		jsonZero    = bytesNull
+		jsonNonZero = []byte(`"one two"`)
+		zero        = gt.NullTrimString(``)
+		nonZero     = gt.NullTrimString(`one two`)
+		dec         = new(gt.NullTrimString)
+	)
+
+	eq(true, zero.IsNull())
+	eq(false, nonZero.IsNull())
+
+	testAny(t, primZero, primNonZero, textZero, textNonZero, jsonZero, jsonNonZero, zero, nonZero, dec)
+}
+
+func TestNullTrimString(t *testing.T) {
+	t.Run(`Parse`, func(t *testing.T) {
+		test := func(exp, src string) {
+			t.Helper()
+
+			var val gt.NullTrimString
+			try(val.Parse(src))
+			eq(gt.NullTrimString(exp), val)
+
+			val = `prev`
+			try(val.UnmarshalText([]byte(src)))
+			eq(gt.NullTrimString(exp), val)
+
+			val = `prev`
+			try(val.UnmarshalJSON(jsonBytes(src)))
+			eq(gt.NullTrimString(exp), val)
+
+			val = `prev`
+			try(val.Scan(src))
+			eq(gt.NullTrimString(exp), val)
+		}
+
+		test(``, ``)
+		test(``, ` `)
+		test(``, " \t\r\n ")
+		test(``, "  ")
+		test(`one`, `one`)
+		test(`one`, `  one  `)
+		test(`one two`, `one two`)
+		test(`one two`, `one   two`)
+		test(`one two three`, " one\t\ttwo \n three ")
+		test(`one two`, "one two")
+		test(`один два`, ` один  два `)
+	})
+
+	t.Run(`Scan`, func(t *testing.T) {
+		var val gt.NullTrimString
+
+		try(val.Scan(gt.NullString(` one `)))
+		eq(gt.NullTrimString(`one`), val)
+
+		try(val.Scan(gt.NullCiString(` Two `)))
+		eq(gt.NullTrimString(`Two`), val)
+
+		// Assigned without normalization.
+		try(val.Scan(gt.NullTrimString(` three `)))
+		eq(gt.NullTrimString(` three `), val)
+
+		fail(val.Scan(10))
+	})
+
+	t.Run(`json`, func(t *testing.T) {
+		var val gt.NullTrimString
+		try(val.UnmarshalJSON([]byte(`"  a \"quoted\"  word "`)))
+		eq(gt.NullTrimString(`a "quoted" word`), val)
+		eq([]byte(`"a \"quoted\" word"`), jsonBytes(val))
+
+		try(val.UnmarshalJSON([]byte(`"   "`)))
+		eq(true, val.IsNull())
+		eq(bytesNull, jsonBytes(val))
+
+		fail(val.UnmarshalJSON([]byte(`10`)))
+	})
+
+	t.Run(`no allocation when normalized`, func(t *testing.T) {
+		var val gt.NullTrimString
+		src := `already normalized`
+		eq(float64(0), testing.AllocsPerRun(16, func() {
+			_ = val.Parse(src)
+		}))
+	})
+}
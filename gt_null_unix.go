@@ -0,0 +1,323 @@
+package gt
+
+import (
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+/*
+Shared implementation of `gt.NullUnixSec`, `gt.NullUnixMilli`,
+`gt.NullUnixMicro`, `gt.NullUnixNano`, which differ only in the unit. Each
+method of these types delegates to the corresponding method of its unit.
+Decoding methods modify the target only on success.
+*/
+type unixUnit time.Duration
+
+const (
+	unixSec   = unixUnit(time.Second)
+	unixMilli = unixUnit(time.Millisecond)
+	unixMicro = unixUnit(time.Microsecond)
+	unixNano  = unixUnit(time.Nanosecond)
+)
+
+func (self unixUnit) from(val int64) time.Time {
+	switch self {
+	case unixSec:
+		return time.Unix(val, 0).In(time.UTC)
+	case unixMilli:
+		return time.UnixMilli(val).In(time.UTC)
+	case unixMicro:
+		return time.UnixMicro(val).In(time.UTC)
+	default:
+		return time.Unix(0, val).In(time.UTC)
+	}
+}
+
+func (self unixUnit) to(val time.Time) int64 {
+	switch self {
+	case unixSec:
+		return val.Unix()
+	case unixMilli:
+		return val.UnixMilli()
+	case unixMicro:
+		return val.UnixMicro()
+	default:
+		return val.UnixNano()
+	}
+}
+
+// Drops sub-unit precision, keeping the location.
+func (self unixUnit) trunc(val time.Time) time.Time {
+	if val.IsZero() {
+		return val
+	}
+	return self.from(self.to(val)).In(val.Location())
+}
+
+func (self unixUnit) int64(val time.Time) int64 {
+	if val.IsZero() {
+		return 0
+	}
+	return self.to(val)
+}
+
+func (self unixUnit) get(val time.Time) any {
+	if val.IsZero() {
+		return nil
+	}
+	return self.to(val)
+}
+
+func (self unixUnit) append(buf []byte, val time.Time) []byte {
+	if val.IsZero() {
+		return buf
+	}
+	return strconv.AppendInt(buf, self.to(val), 10)
+}
+
+func (self unixUnit) string(val time.Time) string {
+	if val.IsZero() {
+		return ``
+	}
+	return bytesString(self.append(nil, val))
+}
+
+func (self unixUnit) text(val time.Time) []byte {
+	if val.IsZero() {
+		return nil
+	}
+	return self.append(nil, val)
+}
+
+func (self unixUnit) json(val time.Time) []byte {
+	if val.IsZero() {
+		return bytesNull
+	}
+	return self.append(nil, val)
+}
+
+func (self unixUnit) parse(tar *time.Time, src string) error {
+	val, err := self.parseTime(src)
+	if err != nil {
+		return err
+	}
+	*tar = val
+	return nil
+}
+
+func (self unixUnit) parseTime(src string) (_ time.Time, err error) {
+	if len(src) <= 0 {
+		return time.Time{}, nil
+	}
+
+	defer errParse(&err, src, `Unix timestamp`)
+
+	num, ok, err := unixParseNum(src)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if ok {
+		return self.from(num), nil
+	}
+
+	val, err := time.Parse(timeFormat, src)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return self.trunc(val), nil
+}
+
+func (self unixUnit) unmarshalJson(tar *time.Time, src []byte) error {
+	if isJsonEmpty(src) {
+		*tar = time.Time{}
+		return nil
+	}
+	if isJsonStr(src) {
+		return self.parse(tar, bytesString(cutJsonStr(src)))
+	}
+	if len(src) > 0 && (charsetDigitDec.has(src[0]) || src[0] == '-') {
+		return self.parse(tar, bytesString(src))
+	}
+	return errJsonUnixTime(src)
+}
+
+/*
+Scans the input into the target. The type is used only for error messages.
+Scanning the other Unix timestamp types truncates them to this unit.
+*/
+func (self unixUnit) scan(tar *time.Time, typ any, src any) error {
+	switch src := src.(type) {
+	case nil:
+		*tar = time.Time{}
+		return nil
+
+	case string:
+		return self.parse(tar, src)
+
+	case []byte:
+		return self.parse(tar, bytesString(src))
+
+	case int64:
+		*tar = self.from(src)
+		return nil
+
+	case time.Time:
+		*tar = self.trunc(src)
+		return nil
+
+	case *time.Time:
+		if src == nil {
+			*tar = time.Time{}
+			return nil
+		}
+		*tar = self.trunc(*src)
+		return nil
+
+	case NullTime:
+		*tar = self.trunc(src.Time())
+		return nil
+
+	case NullUnixSec:
+		*tar = self.trunc(src.Time())
+		return nil
+
+	case NullUnixMilli:
+		*tar = self.trunc(src.Time())
+		return nil
+
+	case NullUnixMicro:
+		*tar = self.trunc(src.Time())
+		return nil
+
+	case NullUnixNano:
+		*tar = self.trunc(src.Time())
+		return nil
+
+	default:
+		val, ok := get(src)
+		if ok {
+			return self.scan(tar, typ, val)
+		}
+		return errScanType(typ, src)
+	}
+}
+
+/*
+Parses a number in JSON syntax, which may have a fraction and an exponent, such
+as "1700000000.5" or "1.7e9", rounding down to an integer. Rounding down is
+consistent with truncation of timestamps to the unit, since the integer is the
+start of the unit which contains the timestamp. Returns false if the input
+isn't a number, and an error if it's out of range.
+*/
+func unixParseNum(src string) (int64, bool, error) {
+	rest := src
+	neg := strings.HasPrefix(rest, `-`)
+	if neg || strings.HasPrefix(rest, `+`) {
+		rest = rest[1:]
+	}
+
+	whole := unixDigits(rest)
+	if len(whole) <= 0 {
+		return 0, false, nil
+	}
+	rest = rest[len(whole):]
+
+	var frac string
+	if strings.HasPrefix(rest, `.`) {
+		frac = unixDigits(rest[1:])
+		if len(frac) <= 0 {
+			return 0, false, nil
+		}
+		rest = rest[1+len(frac):]
+	}
+
+	exp := 0
+	if len(rest) > 0 && (rest[0] == 'e' || rest[0] == 'E') {
+		rest = rest[1:]
+		expNeg := strings.HasPrefix(rest, `-`)
+		if expNeg || strings.HasPrefix(rest, `+`) {
+			rest = rest[1:]
+		}
+
+		digits := unixDigits(rest)
+		if len(digits) <= 0 {
+			return 0, false, nil
+		}
+		rest = rest[len(digits):]
+
+		// Any larger exponent is out of range or rounds to 0 or -1.
+		digits = strings.TrimLeft(digits, `0`)
+		if len(digits) > 3 {
+			digits = `999`
+		}
+		exp, _ = strconv.Atoi(digits)
+		if expNeg {
+			exp = -exp
+		}
+	}
+
+	if len(rest) > 0 {
+		return 0, false, nil
+	}
+
+	// Shift the decimal point, splitting the digits into the integer part and the
+	// dropped part, which only matters for rounding negative numbers.
+	digits := strings.TrimLeft(whole+frac, `0`)
+	if len(digits) <= 0 {
+		return 0, true, nil
+	}
+
+	point := len(digits) - len(frac) + exp
+	if point > 19 {
+		return 0, true, errRange
+	}
+
+	var head, tail string
+	switch {
+	case point <= 0:
+		tail = digits
+	case point >= len(digits):
+		head = digits + strings.Repeat(`0`, point-len(digits))
+	default:
+		head, tail = digits[:point], digits[point:]
+	}
+
+	var val uint64
+	if len(head) > 0 {
+		var err error
+		val, err = strconv.ParseUint(head, 10, 64)
+		if err != nil {
+			return 0, true, errRange
+		}
+	}
+
+	dropped := strings.Trim(tail, `0`) != ``
+
+	if !neg {
+		if val > math.MaxInt64 {
+			return 0, true, errRange
+		}
+		return int64(val), true, nil
+	}
+
+	if dropped {
+		val++
+	}
+	if val > -math.MinInt64 {
+		return 0, true, errRange
+	}
+	// For `-math.MinInt64`, both conversion and negation wrap around, producing
+	// `math.MinInt64`, which is correct.
+	return -int64(val), true, nil
+}
+
+// Returns the longest prefix of decimal digits.
+func unixDigits(src string) string {
+	ind := 0
+	for ind < len(src) && charsetDigitDec.has(src[ind]) {
+		ind++
+	}
+	return src[:ind]
+}
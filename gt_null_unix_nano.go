@@ -0,0 +1,136 @@
+package gt
+
+import (
+	"database/sql/driver"
+	"time"
+)
+
+// Shortcut for converting a Unix timestamp in nanoseconds to `gt.NullUnixNano`.
+func NullUnixNanoFrom(val int64) NullUnixNano { return NullUnixNano(unixNano.from(val)) }
+
+/*
+Shortcut: parses successfully or panics. Should be used only in root scope. When
+error handling is relevant, use `.Parse`.
+*/
+func ParseNullUnixNano(src string) (val NullUnixNano) {
+	try(val.Parse(src))
+	return
+}
+
+/*
+Variant of `gt.NullTime` encoded as an integer Unix timestamp in nanoseconds, in
+text, JSON and SQL. Zero value is considered empty in text, and null in JSON and
+SQL. Decoding also accepts RFC 3339 strings. Can be freely cast to and from
+`gt.NullTime`. The encoding is limited to the years 1678 through 2262, which is
+the range of `int64` nanoseconds.
+
+Suitable for APIs that exchange timestamps as JSON numbers, and for SQL `bigint`
+columns. Scanning also supports `timestamptz`, but since `.Value` produces an
+integer, writing to `timestamptz` columns requires `gt.NullTime`.
+*/
+type NullUnixNano NullTime
+
+var (
+	_ = Encodable(NullUnixNano{})
+	_ = Decodable((*NullUnixNano)(nil))
+)
+
+// Implement `gt.Zeroable`. Same as `self.Time().IsZero()`.
+func (self NullUnixNano) IsZero() bool { return self.Time().IsZero() }
+
+// Implement `gt.Nullable`. True if zero.
+func (self NullUnixNano) IsNull() bool { return self.IsZero() }
+
+/*
+Implement `gt.Getter`. If zero, returns `nil`, otherwise returns `int64`
+representing a Unix timestamp in nanoseconds.
+*/
+func (self NullUnixNano) Get() any { return unixNano.get(self.Time()) }
+
+// Implement `gt.Setter`, using `.Scan`. Panics on error.
+func (self *NullUnixNano) Set(src any) { try(self.Scan(src)) }
+
+// Implement `gt.Zeroer`, zeroing the receiver.
+func (self *NullUnixNano) Zero() {
+	if self != nil {
+		*self = NullUnixNano{}
+	}
+}
+
+/*
+Implement `fmt.Stringer`. If zero, returns an empty string. Otherwise returns
+a decimal integer representing a Unix timestamp in nanoseconds.
+*/
+func (self NullUnixNano) String() string { return unixNano.string(self.Time()) }
+
+/*
+Implement `gt.Parser`. If the input is empty, zeroes the receiver. Otherwise
+requires either a decimal number representing a Unix timestamp in nanoseconds,
+or an RFC 3339 timestamp. The number may have a fraction and an exponent, such
+as "1.5" or "1e3", and is rounded down to a whole nanosecond.
+*/
+func (self *NullUnixNano) Parse(src string) error { return unixNano.parse(self.ptr(), src) }
+
+// Implement `gt.AppenderTo`, using the same representation as `.String`.
+func (self NullUnixNano) AppendTo(buf []byte) []byte { return unixNano.append(buf, self.Time()) }
+
+/*
+Implement `encoding.TextMarhaler`. If zero, returns nil. Otherwise returns the
+same representation as `.String`.
+*/
+func (self NullUnixNano) MarshalText() ([]byte, error) { return unixNano.text(self.Time()), nil }
+
+// Implement `encoding.TextUnmarshaler`, using the same algorithm as `.Parse`.
+func (self *NullUnixNano) UnmarshalText(src []byte) error {
+	return self.Parse(bytesString(src))
+}
+
+/*
+Implement `json.Marshaler`. If zero, returns bytes representing `null`.
+Otherwise returns bytes representing a JSON number with the same text as in
+`.String`.
+*/
+func (self NullUnixNano) MarshalJSON() ([]byte, error) { return unixNano.json(self.Time()), nil }
+
+/*
+Implement `json.Unmarshaler`. If the input is empty or represents JSON `null`,
+zeroes the receiver. Otherwise parses a JSON number or a JSON string, using
+the same algorithm as `.Parse`. Numbers with a fraction or an exponent are
+rounded down to a whole nanosecond.
+*/
+func (self *NullUnixNano) UnmarshalJSON(src []byte) error {
+	return unixNano.unmarshalJson(self.ptr(), src)
+}
+
+// Implement `driver.Valuer`, using `.Get`.
+func (self NullUnixNano) Value() (driver.Value, error) { return self.Get(), nil }
+
+/*
+Implement `sql.Scanner`, converting an arbitrary input to `gt.NullUnixNano` and
+modifying the receiver. Acceptable inputs:
+
+  - `nil`          -> use `.Zero`
+  - `string`       -> use `.Parse`
+  - `[]byte`       -> use `.UnmarshalText`
+  - `int64`        -> Unix timestamp in nanoseconds
+  - `time.Time`    -> assign
+  - `*time.Time`   -> use `.Zero` or assign
+  - `gt.NullTime`  -> assign
+  - `gt.NullUnix*` -> assign
+  - `gt.Getter`    -> scan underlying value
+*/
+func (self *NullUnixNano) Scan(src any) error { return unixNano.scan(self.ptr(), self, src) }
+
+// Free cast to `gt.NullTime`.
+func (self NullUnixNano) NullTime() NullTime { return NullTime(self) }
+
+// Free cast to `time.Time`.
+func (self NullUnixNano) Time() time.Time { return time.Time(self) }
+
+/*
+Returns the Unix timestamp in nanoseconds. If zero, returns 0, which is
+indistinguishable from the Unix epoch; check `.IsNull` when it matters.
+*/
+func (self NullUnixNano) Int64() int64 { return unixNano.int64(self.Time()) }
+
+func (self *NullUnixNano) ptr() *time.Time { return (*time.Time)(self) }
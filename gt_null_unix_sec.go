@@ -0,0 +1,135 @@
+package gt
+
+import (
+	"database/sql/driver"
+	"time"
+)
+
+// Shortcut for converting a Unix timestamp in seconds to `gt.NullUnixSec`.
+func NullUnixSecFrom(val int64) NullUnixSec { return NullUnixSec(unixSec.from(val)) }
+
+/*
+Shortcut: parses successfully or panics. Should be used only in root scope. When
+error handling is relevant, use `.Parse`.
+*/
+func ParseNullUnixSec(src string) (val NullUnixSec) {
+	try(val.Parse(src))
+	return
+}
+
+/*
+Variant of `gt.NullTime` encoded as an integer Unix timestamp in seconds, in
+text, JSON and SQL. Zero value is considered empty in text, and null in JSON and
+SQL. Decoding also accepts RFC 3339 strings; precision finer than a second is
+dropped on decode. Can be freely cast to and from `gt.NullTime`.
+
+Suitable for APIs that exchange timestamps as JSON numbers, and for SQL `bigint`
+columns. Scanning also supports `timestamptz`, but since `.Value` produces an
+integer, writing to `timestamptz` columns requires `gt.NullTime`.
+*/
+type NullUnixSec NullTime
+
+var (
+	_ = Encodable(NullUnixSec{})
+	_ = Decodable((*NullUnixSec)(nil))
+)
+
+// Implement `gt.Zeroable`. Same as `self.Time().IsZero()`.
+func (self NullUnixSec) IsZero() bool { return self.Time().IsZero() }
+
+// Implement `gt.Nullable`. True if zero.
+func (self NullUnixSec) IsNull() bool { return self.IsZero() }
+
+/*
+Implement `gt.Getter`. If zero, returns `nil`, otherwise returns `int64`
+representing a Unix timestamp in seconds.
+*/
+func (self NullUnixSec) Get() any { return unixSec.get(self.Time()) }
+
+// Implement `gt.Setter`, using `.Scan`. Panics on error.
+func (self *NullUnixSec) Set(src any) { try(self.Scan(src)) }
+
+// Implement `gt.Zeroer`, zeroing the receiver.
+func (self *NullUnixSec) Zero() {
+	if self != nil {
+		*self = NullUnixSec{}
+	}
+}
+
+/*
+Implement `fmt.Stringer`. If zero, returns an empty string. Otherwise returns
+a decimal integer representing a Unix timestamp in seconds.
+*/
+func (self NullUnixSec) String() string { return unixSec.string(self.Time()) }
+
+/*
+Implement `gt.Parser`. If the input is empty, zeroes the receiver. Otherwise
+requires either a decimal number representing a Unix timestamp in seconds,
+or an RFC 3339 timestamp. The number may have a fraction and an exponent, such
+as "1.5" or "1e3", and is rounded down to a whole second.
+*/
+func (self *NullUnixSec) Parse(src string) error { return unixSec.parse(self.ptr(), src) }
+
+// Implement `gt.AppenderTo`, using the same representation as `.String`.
+func (self NullUnixSec) AppendTo(buf []byte) []byte { return unixSec.append(buf, self.Time()) }
+
+/*
+Implement `encoding.TextMarhaler`. If zero, returns nil. Otherwise returns the
+same representation as `.String`.
+*/
+func (self NullUnixSec) MarshalText() ([]byte, error) { return unixSec.text(self.Time()), nil }
+
+// Implement `encoding.TextUnmarshaler`, using the same algorithm as `.Parse`.
+func (self *NullUnixSec) UnmarshalText(src []byte) error {
+	return self.Parse(bytesString(src))
+}
+
+/*
+Implement `json.Marshaler`. If zero, returns bytes representing `null`.
+Otherwise returns bytes representing a JSON number with the same text as in
+`.String`.
+*/
+func (self NullUnixSec) MarshalJSON() ([]byte, error) { return unixSec.json(self.Time()), nil }
+
+/*
+Implement `json.Unmarshaler`. If the input is empty or represents JSON `null`,
+zeroes the receiver. Otherwise parses a JSON number or a JSON string, using
+the same algorithm as `.Parse`. Numbers with a fraction or an exponent are
+rounded down to a whole second.
+*/
+func (self *NullUnixSec) UnmarshalJSON(src []byte) error {
+	return unixSec.unmarshalJson(self.ptr(), src)
+}
+
+// Implement `driver.Valuer`, using `.Get`.
+func (self NullUnixSec) Value() (driver.Value, error) { return self.Get(), nil }
+
+/*
+Implement `sql.Scanner`, converting an arbitrary input to `gt.NullUnixSec` and
+modifying the receiver. Acceptable inputs:
+
+  - `nil`          -> use `.Zero`
+  - `string`       -> use `.Parse`
+  - `[]byte`       -> use `.UnmarshalText`
+  - `int64`        -> Unix timestamp in seconds
+  - `time.Time`    -> truncate and assign
+  - `*time.Time`   -> use `.Zero` or truncate and assign
+  - `gt.NullTime`  -> truncate and assign
+  - `gt.NullUnix*` -> truncate and assign
+  - `gt.Getter`    -> scan underlying value
+*/
+func (self *NullUnixSec) Scan(src any) error { return unixSec.scan(self.ptr(), self, src) }
+
+// Free cast to `gt.NullTime`.
+func (self NullUnixSec) NullTime() NullTime { return NullTime(self) }
+
+// Free cast to `time.Time`.
+func (self NullUnixSec) Time() time.Time { return time.Time(self) }
+
+/*
+Returns the Unix timestamp in seconds. If zero, returns 0, which is
+indistinguishable from the Unix epoch; check `.IsNull` when it matters.
+*/
+func (self NullUnixSec) Int64() int64 { return unixSec.int64(self.Time()) }
+
+func (self *NullUnixSec) ptr() *time.Time { return (*time.Time)(self) }
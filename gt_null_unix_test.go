@@ -0,0 +1,177 @@
+package gt_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/mitranim/gt"
+)
+
+func TestNullUnixSec_common(t *testing.T) {
+	var (
+		primZero    = any(nil)
+		primNonZero = int64(1234567890)
+		textZero    = ``
+		textNonZero = `1234567890`
+		jsonZero    = bytesNull
+		jsonNonZero = []byte(textNonZero)
+		zero        = gt.NullUnixSec{}
+		nonZero     = gt.NullUnixSecFrom(primNonZero)
+		dec         = new(gt.NullUnixSec)
+	)
+
+	eq(true, zero.IsNull())
+	eq(false, nonZero.IsNull())
+
+	testAny(t, primZero, primNonZero, textZero, textNonZero, jsonZero, jsonNonZero, zero, nonZero, dec)
+}
+
+func TestNullUnixMilli_common(t *testing.T) {
+	var (
+		primZero    = any(nil)
+		primNonZero = int64(1234567890123)
+		textZero    = ``
+		textNonZero = `1234567890123`
+		jsonZero    = bytesNull
+		jsonNonZero = []byte(textNonZero)
+		zero        = gt.NullUnixMilli{}
+		nonZero     = gt.NullUnixMilliFrom(primNonZero)
+		dec         = new(gt.NullUnixMilli)
+	)
+
+	eq(true, zero.IsNull())
+	eq(false, nonZero.IsNull())
+
+	testAny(t, primZero, primNonZero, textZero, textNonZero, jsonZero, jsonNonZero, zero, nonZero, dec)
+}
+
+func TestNullUnixMicro_common(t *testing.T) {
+	var (
+		primZero    = any(nil)
+		primNonZero = int64(1234567890123456)
+		textZero    = ``
+		textNonZero = `1234567890123456`
+		jsonZero    = bytesNull
+		jsonNonZero = []byte(textNonZero)
+		zero        = gt.NullUnixMicro{}
+		nonZero     = gt.NullUnixMicroFrom(primNonZero)
+		dec         = new(gt.NullUnixMicro)
+	)
+
+	eq(true, zero.IsNull())
+	eq(false, nonZero.IsNull())
+
+	testAny(t, primZero, primNonZero, textZero, textNonZero, jsonZero, jsonNonZero, zero, nonZero, dec)
+}
+
+func TestNullUnixNano_common(t *testing.T) {
+	var (
+		primZero    = any(nil)
+		primNonZero = int64(1234567890123456789)
+		textZero    = ``
+		textNonZero = `1234567890123456789`
+		jsonZero    = bytesNull
+		jsonNonZero = []byte(textNonZero)
+		zero        = gt.NullUnixNano{}
+		nonZero     = gt.NullUnixNanoFrom(primNonZero)
+		dec         = new(gt.NullUnixNano)
+	)
+
+	eq(true, zero.IsNull())
+	eq(false, nonZero.IsNull())
+
+	testAny(t, primZero, primNonZero, textZero, textNonZero, jsonZero, jsonNonZero, zero, nonZero, dec)
+}
+
+func TestNullUnixMilli(t *testing.T) {
+	t.Run(`Parse`, func(t *testing.T) {
+		test := func(exp gt.NullTime, src string) {
+			t.Helper()
+			eq(exp, gt.ParseNullUnixMilli(src).NullTime())
+		}
+
+		test(gt.NullTime{}, ``)
+		test(gt.NullDateUTC(1970, 1, 1), `0`)
+		test(gt.NullTimeUTC(1930, 11, 18, 0, 28, 29, 877000000), `-1234567890123`)
+		test(gt.NullTimeUTC(2009, 2, 13, 23, 31, 30, 123000000), `1234567890123`)
+		test(gt.NullTimeUTC(2009, 2, 13, 23, 31, 30, 123000000), `2009-02-13T23:31:30.123456789Z`)
+
+		// Fractions and exponents are rounded down to a whole unit.
+		test(gt.NullTimeUTC(2009, 2, 13, 23, 31, 30, 123000000), `1234567890123.9`)
+		test(gt.NullTimeUTC(2009, 2, 13, 23, 31, 30, 120000000), `1.23456789012e12`)
+		test(gt.NullTimeUTC(2009, 2, 13, 23, 31, 30, 123000000), `+1234567890123`)
+		test(gt.NullTimeUTC(1969, 12, 31, 23, 59, 59, 998000000), `-1.5`)
+		test(gt.NullDateUTC(1970, 1, 1), `0.5`)
+		test(gt.NullDateUTC(1970, 1, 1), `0e999999`)
+		test(gt.NullDateUTC(1970, 1, 1), `5e-999999`)
+
+		var val gt.NullUnixMilli
+		fail(val.Parse(`2009-02-13`))
+		fail(val.Parse(`1.`))
+		fail(val.Parse(`.5`))
+		fail(val.Parse(`1e`))
+		fail(val.Parse(`1.5x`))
+		fail(val.Parse(`1e19`))
+		fail(val.Parse(`9223372036854775808`))
+		fail(val.Parse(`-9223372036854775808.5`))
+		eq(gt.NullUnixMilli{}, val)
+	})
+
+	t.Run(`UnmarshalJSON`, func(t *testing.T) {
+		var val gt.NullUnixMilli
+
+		try(val.UnmarshalJSON([]byte(`"2009-02-13T23:31:30.123Z"`)))
+		eq(int64(1234567890123), val.Int64())
+
+		try(val.UnmarshalJSON([]byte(`-1`)))
+		eq(gt.NullTimeUTC(1969, 12, 31, 23, 59, 59, 999000000), val.NullTime())
+
+		try(val.UnmarshalJSON([]byte(`1.5`)))
+		eq(int64(1), val.Int64())
+
+		fail(val.UnmarshalJSON([]byte(`true`)))
+		fail(val.UnmarshalJSON([]byte(`1e400`)))
+		eq(int64(1), val.Int64())
+	})
+
+	t.Run(`Scan`, func(t *testing.T) {
+		var val gt.NullUnixMilli
+
+		loc, err := time.LoadLocation(`Europe/Berlin`)
+		try(err)
+
+		try(val.Scan(time.Date(2009, 2, 14, 0, 31, 30, 123456789, loc)))
+		eq(int64(1234567890123), val.Int64())
+		eq(loc, val.Time().Location())
+
+		try(val.Scan(gt.NullUnixSecFrom(1234567890)))
+		eq(int64(1234567890000), val.Int64())
+
+		fail(val.Scan(1.5))
+	})
+
+	t.Run(`fractional JSON`, func(t *testing.T) {
+		var sec gt.NullUnixSec
+		try(sec.UnmarshalJSON([]byte(`1700000000.5`)))
+		eq(int64(1700000000), sec.Int64())
+
+		try(sec.UnmarshalJSON([]byte(`1.7e9`)))
+		eq(int64(1700000000), sec.Int64())
+
+		var nano gt.NullUnixNano
+		try(nano.UnmarshalJSON([]byte(`1.700000000123456789e18`)))
+		eq(int64(1700000000123456789), nano.Int64())
+
+		try(nano.UnmarshalJSON([]byte(`-9223372036854775808`)))
+		eq(int64(-9223372036854775808), nano.Int64())
+	})
+
+	t.Run(`conversion`, func(t *testing.T) {
+		src := gt.NullTimeUTC(2009, 2, 13, 23, 31, 30, 123456789)
+
+		eq(`1234567890`, gt.NullUnixSec(src).String())
+		eq(`1234567890123`, gt.NullUnixMilli(src).String())
+		eq(`1234567890123456`, gt.NullUnixMicro(src).String())
+		eq(`1234567890123456789`, gt.NullUnixNano(src).String())
+	})
+}
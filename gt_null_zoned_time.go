@@ -0,0 +1,414 @@
+package gt
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"strings"
+	"time"
+)
+
+/*
+Shortcut: parses successfully or panics. Should be used only in root scope. When
+error handling is relevant, use `.Parse`.
+*/
+func ParseNullZonedTime(src string) (val NullZonedTime) {
+	try(val.Parse(src))
+	return
+}
+
+/*
+Converts the timestamp to the location with the given IANA name, such as
+"Europe/Berlin". Useful for SQL tables that store the instant in a `timestamptz`
+column and the zone name in a separate text column. Empty name means UTC.
+*/
+func NullZonedTimeFrom(val NullTime, zone string) (NullZonedTime, error) {
+	if val.IsZero() {
+		return NullZonedTime{}, nil
+	}
+
+	loc, err := loadLocation(zone)
+	if err != nil {
+		return NullZonedTime{}, err
+	}
+	return NullZonedTime(val.Time().In(loc)), nil
+}
+
+/*
+Variant of `gt.NullTime` that preserves the IANA time zone name. Encodes in
+text and JSON as RFC 9557 (Internet Extended Date/Time Format):
+
+	2024-05-01T10:00:00+02:00[Europe/Berlin]
+
+Decoding re-resolves the location, which means DST-aware arithmetic such as
+`.AddDate` remains correct after round-tripping, unlike `gt.NullTime` which
+preserves only the offset. Timestamps in UTC, or with a fixed offset and no zone
+name, are encoded without the suffix, as plain RFC 3339.
+
+Decoding ignores elective RFC 9557 annotations such as "[u-ca=iso8601]" and
+rejects critical ones such as "[!u-ca=iso8601]". If the offset doesn't match
+the zone at that instant, decoding fails. The exception is "Z", which RFC 9557
+defines as an unknown local offset: "2024-05-01T08:00:00Z[Europe/Berlin]" is
+converted to the zone, becoming "2024-05-01T10:00:00+02:00[Europe/Berlin]".
+
+The zone "UTC" is indistinguishable from the default UTC location, and is
+encoded without the suffix: "2024-05-01T08:00:00Z[UTC]" is decoded as
+"2024-05-01T08:00:00Z". To preserve an explicit zone name, use "Etc/UTC".
+
+In SQL, `.Value` produces the same text as `.String`, suitable for a single text
+column. When storing the instant in `timestamptz` and the zone in a separate
+column, use `.NullTime` and `.ZoneName` for encoding, and
+`gt.NullZonedTimeFrom` for decoding.
+*/
+type NullZonedTime NullTime
+
+var (
+	_ = Encodable(NullZonedTime{})
+	_ = Decodable((*NullZonedTime)(nil))
+)
+
+// Implement `gt.Zeroable`. Same as `self.Time().IsZero()`.
+func (self NullZonedTime) IsZero() bool { return self.Time().IsZero() }
+
+// Implement `gt.Nullable`. True if zero.
+func (self NullZonedTime) IsNull() bool { return self.IsZero() }
+
+/*
+Implement `gt.Getter`. If zero, returns `nil`, otherwise uses `.String` to
+return a string representation.
+*/
+func (self NullZonedTime) Get() any {
+	if self.IsNull() {
+		return nil
+	}
+	return self.String()
+}
+
+// Implement `gt.Setter`, using `.Scan`. Panics on error.
+func (self *NullZonedTime) Set(src any) { try(self.Scan(src)) }
+
+// Implement `gt.Zeroer`, zeroing the receiver.
+func (self *NullZonedTime) Zero() {
+	if self != nil {
+		*self = NullZonedTime{}
+	}
+}
+
+/*
+Implement `fmt.Stringer`. If zero, returns an empty string. Otherwise returns a
+text representation in the RFC 9557 format, see `gt.NullZonedTime`.
+*/
+func (self NullZonedTime) String() string {
+	if self.IsNull() {
+		return ``
+	}
+	return bytesString(self.AppendTo(nil))
+}
+
+/*
+Implement `gt.Parser`. If the input is empty, zeroes the receiver. Otherwise
+requires an RFC 3339 timestamp, optionally followed by RFC 9557 annotations,
+where the first may be a time zone name or offset.
+*/
+func (self *NullZonedTime) Parse(src string) (err error) {
+	if len(src) <= 0 {
+		self.Zero()
+		return nil
+	}
+
+	defer errParse(&err, src, `zoned time`)
+
+	text, zone, err := cutZonedTimeSuffix(src)
+	if err != nil {
+		return err
+	}
+
+	val, err := time.Parse(timeFormat, text)
+	if err != nil {
+		return err
+	}
+
+	if len(zone) > 0 {
+		val, err = zonedTimeIn(val, zone, isZonedTimeUnknownOffset(text))
+		if err != nil {
+			return err
+		}
+	}
+
+	*self = NullZonedTime(val)
+	return nil
+}
+
+// Implement `gt.AppenderTo`, using the same representation as `.String`.
+func (self NullZonedTime) AppendTo(buf []byte) []byte {
+	if self.IsNull() {
+		return buf
+	}
+
+	buf = self.Time().AppendFormat(buf, timeFormat)
+
+	zone := self.ZoneName()
+	if len(zone) > 0 {
+		buf = append(buf, '[')
+		buf = append(buf, zone...)
+		buf = append(buf, ']')
+	}
+	return buf
+}
+
+/*
+Implement `encoding.TextMarhaler`. If zero, returns nil. Otherwise returns the
+same representation as `.String`.
+*/
+func (self NullZonedTime) MarshalText() ([]byte, error) {
+	if self.IsNull() {
+		return nil, nil
+	}
+	return self.AppendTo(nil), nil
+}
+
+// Implement `encoding.TextUnmarshaler`, using the same algorithm as `.Parse`.
+func (self *NullZonedTime) UnmarshalText(src []byte) error {
+	return self.Parse(bytesString(src))
+}
+
+/*
+Implement `json.Marshaler`. If zero, returns bytes representing `null`.
+Otherwise returns bytes representing a JSON string with the same text as in
+`.String`.
+*/
+func (self NullZonedTime) MarshalJSON() ([]byte, error) {
+	if self.IsNull() {
+		return bytesNull, nil
+	}
+
+	buf := make([]byte, 0, len(timeFormat)+32)
+	buf = append(buf, '"')
+	buf = self.AppendTo(buf)
+	buf = append(buf, '"')
+	return buf, nil
+}
+
+/*
+Implement `json.Unmarshaler`. If the input is empty or represents JSON `null`,
+zeroes the receiver. Otherwise parses a JSON string, using the same algorithm
+as `.Parse`.
+*/
+func (self *NullZonedTime) UnmarshalJSON(src []byte) error {
+	if isJsonEmpty(src) {
+		self.Zero()
+		return nil
+	}
+
+	if isJsonStr(src) {
+		return self.UnmarshalText(cutJsonStr(src))
+	}
+
+	return errJsonString(src, self)
+}
+
+// Implement `driver.Valuer`, using `.Get`.
+func (self NullZonedTime) Value() (driver.Value, error) {
+	return self.Get(), nil
+}
+
+/*
+Implement `sql.Scanner`, converting an arbitrary input to `gt.NullZonedTime`
+and modifying the receiver. Acceptable inputs:
+
+  - `nil`              -> use `.Zero`
+  - `string`           -> use `.Parse`
+  - `[]byte`           -> use `.UnmarshalText`
+  - `time.Time`        -> assign
+  - `*time.Time`       -> use `.Zero` or assign
+  - `gt.NullTime`      -> assign
+  - `gt.NullZonedTime` -> assign
+  - `gt.Getter`        -> scan underlying value
+
+Note that `timestamptz` columns don't store the zone name; the resulting
+location is whatever the driver uses.
+*/
+func (self *NullZonedTime) Scan(src any) error {
+	switch src := src.(type) {
+	case nil:
+		self.Zero()
+		return nil
+
+	case string:
+		return self.Parse(src)
+
+	case []byte:
+		return self.UnmarshalText(src)
+
+	case time.Time:
+		*self = NullZonedTime(src)
+		return nil
+
+	case *time.Time:
+		if src == nil {
+			self.Zero()
+		} else {
+			*self = NullZonedTime(*src)
+		}
+		return nil
+
+	case NullTime:
+		*self = NullZonedTime(src)
+		return nil
+
+	case NullZonedTime:
+		*self = src
+		return nil
+
+	default:
+		val, ok := get(src)
+		if ok {
+			return self.Scan(val)
+		}
+		return errScanType(self, src)
+	}
+}
+
+// Free cast to `gt.NullTime`.
+func (self NullZonedTime) NullTime() NullTime { return NullTime(self) }
+
+// Free cast to `time.Time`.
+func (self NullZonedTime) Time() time.Time { return time.Time(self) }
+
+// Same as `time.Time.Location`.
+func (self NullZonedTime) Location() *time.Location { return self.Time().Location() }
+
+// Shortcut for `gt.NullLocationFrom(self.Location())`. If zero, returns zero.
+func (self NullZonedTime) NullLocation() NullLocation {
+	if self.IsNull() {
+		return NullLocation{}
+	}
+	return NullLocationFrom(self.Location())
+}
+
+/*
+Returns the IANA name of the timestamp's location, such as "Europe/Berlin".
+Returns an empty string for UTC, the process-local zone, and zones without a
+name, such as fixed offsets parsed from RFC 3339.
+*/
+func (self NullZonedTime) ZoneName() string {
+	return zoneName(self.Location())
+}
+
+// `gt.NullZonedTime` version of `time.Time.Add`. Preserves the location.
+func (self NullZonedTime) Add(val time.Duration) NullZonedTime {
+	return NullZonedTime(self.Time().Add(val))
+}
+
+/*
+`gt.NullZonedTime` version of `time.Time.AddDate`. Preserves the location and
+uses its DST rules.
+*/
+func (self NullZonedTime) AddDate(years, months, days int) NullZonedTime {
+	return NullZonedTime(self.Time().AddDate(years, months, days))
+}
+
+func zoneName(loc *time.Location) string {
+	if loc == nil || loc == time.UTC || loc == time.Local {
+		return ``
+	}
+	name := loc.String()
+	if name == `UTC` || name == `Local` {
+		return ``
+	}
+	return name
+}
+
+/*
+Splits an RFC 9557 timestamp into the RFC 3339 part and the time zone
+annotation, if any, validating other annotations.
+*/
+func cutZonedTimeSuffix(src string) (string, string, error) {
+	ind := strings.IndexByte(src, '[')
+	if ind < 0 {
+		return src, ``, nil
+	}
+
+	text, rest, zone := src[:ind], src[ind:], ``
+
+	for first := true; len(rest) > 0; first = false {
+		end := strings.IndexByte(rest, ']')
+		if rest[0] != '[' || end < 0 {
+			return ``, ``, errFormatMismatch
+		}
+
+		tag := rest[1:end]
+		rest = rest[end+1:]
+
+		crit := strings.HasPrefix(tag, `!`)
+		if crit {
+			tag = tag[1:]
+		}
+
+		if len(tag) <= 0 {
+			return ``, ``, errFormatMismatch
+		}
+
+		if strings.IndexByte(tag, '=') >= 0 {
+			if crit {
+				return ``, ``, fmt.Errorf(`unsupported critical annotation %q`, tag)
+			}
+			continue
+		}
+
+		if !first {
+			return ``, ``, fmt.Errorf(`unexpected time zone annotation %q`, tag)
+		}
+		zone = tag
+	}
+
+	return text, zone, nil
+}
+
+/*
+Converts the timestamp to the given zone, which may be an IANA name or a numeric
+offset. Unless the local offset is unknown, the offset of the input must match
+the offset of the zone at that instant.
+*/
+func zonedTimeIn(val time.Time, zone string, unknown bool) (time.Time, error) {
+	_, off := val.Zone()
+
+	if zone[0] == '+' || zone[0] == '-' {
+		tar, err := time.Parse(`-07:00`, zone)
+		if err != nil {
+			return val, err
+		}
+		if unknown {
+			return val.In(tar.Location()), nil
+		}
+		_, tarOff := tar.Zone()
+		if tarOff != off {
+			return val, fmt.Errorf(`offset inconsistent with time zone %q`, zone)
+		}
+		return val, nil
+	}
+
+	loc, err := loadLocation(zone)
+	if err != nil {
+		return val, err
+	}
+
+	out := val.In(loc)
+	if unknown {
+		return out, nil
+	}
+
+	_, outOff := out.Zone()
+	if outOff != off {
+		return val, fmt.Errorf(`offset inconsistent with time zone %q`, zone)
+	}
+	return out, nil
+}
+
+/*
+True if the RFC 3339 part of an RFC 9557 timestamp ends with "Z", which RFC
+9557 defines as an unknown local offset, rather than a UTC offset that must
+match the zone annotation.
+*/
+func isZonedTimeUnknownOffset(src string) bool {
+	return strings.HasSuffix(src, `Z`)
+}
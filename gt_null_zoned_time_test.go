@@ -0,0 +1,99 @@
+package gt_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/mitranim/gt"
+)
+
+func TestNullZonedTime_common(t *testing.T) {
+	var (
+		primZero    = any(nil)
+		primNonZero = `2024-05-01T10:00:00+02:00[Europe/Berlin]`
+		textZero    = ``
+		textNonZero = primNonZero
+		jsonZero    = bytesNull
+		jsonNonZero = jsonBytes(textNonZero)
+		zero        = gt.NullZonedTime{}
+		nonZero     = gt.ParseNullZonedTime(textNonZero)
+		dec         = new(gt.NullZonedTime)
+	)
+
+	eq(true, zero.IsNull())
+	eq(false, nonZero.IsNull())
+
+	testAny(t, primZero, primNonZero, textZero, textNonZero, jsonZero, jsonNonZero, zero, nonZero, dec)
+}
+
+func TestNullZonedTime(t *testing.T) {
+	loc, err := time.LoadLocation(`Europe/Berlin`)
+	try(err)
+
+	t.Run(`Parse`, func(t *testing.T) {
+		test := func(exp string, src string) {
+			t.Helper()
+			eq(exp, gt.ParseNullZonedTime(src).String())
+		}
+
+		test(``, ``)
+		test(`2024-05-01T10:00:00Z`, `2024-05-01T10:00:00Z`)
+		test(`2024-05-01T10:00:00+02:00`, `2024-05-01T10:00:00+02:00`)
+		test(`2024-05-01T10:00:00+02:00`, `2024-05-01T10:00:00+02:00[+02:00]`)
+		test(`2024-05-01T10:00:00+02:00[Europe/Berlin]`, `2024-05-01T10:00:00+02:00[!Europe/Berlin]`)
+		test(`2024-05-01T10:00:00+02:00[Europe/Berlin]`, `2024-05-01T10:00:00+02:00[Europe/Berlin][u-ca=iso8601]`)
+		test(`2024-01-01T10:00:00.123+01:00[Europe/Berlin]`, `2024-01-01T10:00:00.123+01:00[Europe/Berlin]`)
+
+		// RFC 9557: "Z" with a zone means the local offset is unknown.
+		test(`2024-05-01T10:00:00+02:00[Europe/Berlin]`, `2024-05-01T08:00:00Z[Europe/Berlin]`)
+		test(`2024-01-01T09:00:00+01:00[Europe/Berlin]`, `2024-01-01T08:00:00Z[Europe/Berlin]`)
+		test(`2024-05-01T10:00:00+02:00`, `2024-05-01T08:00:00Z[+02:00]`)
+
+		// "UTC" is the default location, and is encoded without the suffix.
+		test(`2024-05-01T08:00:00Z`, `2024-05-01T08:00:00Z[UTC]`)
+		test(`2024-05-01T08:00:00Z[Etc/UTC]`, `2024-05-01T08:00:00Z[Etc/UTC]`)
+
+		eq(loc.String(), gt.ParseNullZonedTime(`2024-05-01T10:00:00+02:00[Europe/Berlin]`).Location().String())
+	})
+
+	t.Run(`Parse invalid`, func(t *testing.T) {
+		var val gt.NullZonedTime
+
+		fail(val.Parse(`2024-05-01T10:00:00+01:00[Europe/Berlin]`))
+		fail(val.Parse(`2024-05-01T10:00:00+00:00[Europe/Berlin]`))
+		fail(val.Parse(`2024-05-01T10:00:00+02:00[+01:00]`))
+		fail(val.Parse(`2024-05-01T10:00:00+02:00[Mars/Olympus]`))
+		fail(val.Parse(`2024-05-01T10:00:00+02:00[Europe/Berlin`))
+		fail(val.Parse(`2024-05-01T10:00:00+02:00[]`))
+		fail(val.Parse(`2024-05-01T10:00:00+02:00[!u-ca=iso8601]`))
+		fail(val.Parse(`2024-05-01T10:00:00+02:00[u-ca=iso8601][Europe/Berlin]`))
+		fail(val.Parse(`2024-05-01[Europe/Berlin]`))
+		eq(gt.NullZonedTime{}, val)
+	})
+
+	t.Run(`AddDate`, func(t *testing.T) {
+		val := gt.ParseNullZonedTime(gt.ParseNullZonedTime(`2024-03-30T10:00:00+01:00[Europe/Berlin]`).String())
+		eq(`2024-03-31T10:00:00+02:00[Europe/Berlin]`, val.AddDate(0, 0, 1).String())
+		eq(`2024-03-31T11:00:00+02:00[Europe/Berlin]`, val.Add(24*time.Hour).String())
+	})
+
+	t.Run(`NullZonedTimeFrom`, func(t *testing.T) {
+		val, err := gt.NullZonedTimeFrom(gt.NullTimeUTC(2024, 5, 1, 8, 0, 0, 0), `Europe/Berlin`)
+		try(err)
+		eq(`2024-05-01T10:00:00+02:00[Europe/Berlin]`, val.String())
+		eq(`Europe/Berlin`, val.ZoneName())
+
+		val, err = gt.NullZonedTimeFrom(gt.NullTime{}, `Europe/Berlin`)
+		try(err)
+		eq(gt.NullZonedTime{}, val)
+
+		_, err = gt.NullZonedTimeFrom(gt.NullTimeUTC(2024, 5, 1, 8, 0, 0, 0), `Mars/Olympus`)
+		fail(err)
+	})
+
+	t.Run(`Scan`, func(t *testing.T) {
+		var val gt.NullZonedTime
+		try(val.Scan(time.Date(2024, 5, 1, 10, 0, 0, 0, loc)))
+		eq(`2024-05-01T10:00:00+02:00[Europe/Berlin]`, val.String())
+	})
+}
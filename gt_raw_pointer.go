@@ -0,0 +1,164 @@
+package gt
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+/*
+Looks up a value by a JSON Pointer (RFC 6901), such as "/a/0/b", without
+decoding the document. Returns a subslice of self, without surrounding
+whitespace, and true if found. The empty pointer refers to the whole document.
+Array elements are referenced by decimal indexes; "-" never matches. Doesn't
+allocate unless the pointer or the matched keys contain escape sequences.
+
+Assumes that self is valid JSON. For invalid JSON, the result is unspecified,
+but this never panics. To guarantee validity, use `gt.NullJson`.
+*/
+func (self Raw) Pointer(ptr string) (Raw, bool) {
+	start, end, ok := jsonPointerFind(self, ptr)
+	if !ok {
+		return nil, false
+	}
+	return self[start:end:end], true
+}
+
+/*
+Returns a copy of self where the location referenced by the JSON Pointer is set
+to the given value, which must be valid JSON. Existing object members and array
+elements are replaced. Missing object members are appended. For arrays, the
+index equal to the array's length, or the token "-", appends a new element. The
+parent of the location must exist. The empty pointer replaces the whole
+document. Doesn't modify self.
+
+Assumes that self is valid JSON. To guarantee validity, use `gt.NullJson`.
+*/
+func (self Raw) SetPointer(ptr string, val Raw) (_ Raw, err error) {
+	defer errJsonPointer(&err, ptr)
+
+	val = bytes.TrimSpace(val)
+	if !json.Valid(val) {
+		return nil, errJsonPointerValue
+	}
+	if len(ptr) <= 0 {
+		return append(Raw(nil), val...), nil
+	}
+	if !jsonPointerValid(ptr) {
+		return nil, errJsonPointerSyntax
+	}
+
+	var iter jsonIter
+	tok, ok := self.pointerSeek(ptr, &iter)
+	if iter.failed {
+		return nil, errJsonPointerParent
+	}
+	if ok {
+		return rawSplice(self, iter.start, iter.end, val), nil
+	}
+
+	var prefix []byte
+	if iter.count > 0 {
+		prefix = append(prefix, ',')
+	}
+
+	if iter.obj {
+		prefix = jsonAppendString(prefix, tok)
+		prefix = append(prefix, ':')
+	} else if tok != `-` {
+		ind, ok := jsonPointerIndex(tok)
+		if !ok || ind != iter.count {
+			return nil, errJsonPointerIndex
+		}
+	}
+
+	return rawSplice(self, iter.pos, iter.pos, prefix, val), nil
+}
+
+/*
+Returns a copy of self without the object member or array element referenced by
+the JSON Pointer, and true if it was found. If not found, returns nil and false.
+The empty pointer refers to the whole document, and deleting it returns nil and
+true. Doesn't modify self.
+
+Assumes that self is valid JSON. To guarantee validity, use `gt.NullJson`.
+*/
+func (self Raw) DeletePointer(ptr string) (Raw, bool) {
+	if len(ptr) <= 0 {
+		return nil, len(jsonTrimValue(self)) > 0
+	}
+
+	var iter jsonIter
+	_, ok := self.pointerSeek(ptr, &iter)
+	if !ok {
+		return nil, false
+	}
+
+	start, end := iter.cut()
+	return rawSplice(self, start, end), true
+}
+
+/*
+Returns the result of applying the given JSON Merge Patch (RFC 7386) to self.
+Patch members with `null` values delete the corresponding members of self, and
+other members are merged recursively. A patch which isn't an object replaces
+the document entirely. Empty self or patch is treated as `null`. Members of
+self keep their order, and new members are appended in patch order. Doesn't
+modify self or the patch.
+
+Unlike other JSON methods of `gt.Raw`, this validates both inputs, and returns
+an error if either is invalid.
+*/
+func (self Raw) MergePatch(patch Raw) (Raw, error) {
+	tar := bytes.TrimSpace(self)
+	if len(tar) > 0 && !json.Valid(tar) {
+		return nil, errInvalidJson(self)
+	}
+
+	patch = bytes.TrimSpace(patch)
+	if len(patch) <= 0 {
+		return nil, nil
+	}
+	if !json.Valid(patch) {
+		return nil, errInvalidJson(patch)
+	}
+
+	out := jsonMergePatch(make([]byte, 0, len(tar)+len(patch)), tar, patch)
+	if isJsonNull(out) {
+		return nil, nil
+	}
+	return out, nil
+}
+
+/*
+Finds the parent container referenced by all but the last token, and seeks the
+last token in it. Returns the unescaped last token.
+*/
+func (self Raw) pointerSeek(ptr string, iter *jsonIter) (string, bool) {
+	parent, tok, ok := jsonPointerSplit(ptr)
+	if !ok {
+		iter.failed = true
+		return ``, false
+	}
+
+	start, _, ok := jsonPointerFind(self, parent)
+	if !ok {
+		iter.failed = true
+		return tok, false
+	}
+	return tok, iter.seek(self, start, tok)
+}
+
+// Returns a copy of the source where the span is replaced by the given parts.
+func rawSplice(src []byte, start, end int, parts ...[]byte) Raw {
+	size := len(src) - (end - start)
+	for _, part := range parts {
+		size += len(part)
+	}
+
+	out := make(Raw, 0, size)
+	out = append(out, src[:start]...)
+	for _, part := range parts {
+		out = append(out, part...)
+	}
+	return append(out, src[end:]...)
+}
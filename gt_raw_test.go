@@ -1,6 +1,7 @@
 package gt_test
 
 import (
+	"bytes"
 	"fmt"
 	"testing"
 
@@ -84,4 +85,198 @@ func TestRaw(t *testing.T) {
 		// Kind of unfortunate since backquoted strings can include newlines.
 		test(`gt.Raw("\n")`, gt.Raw("\n"))
 	})
+
+	t.Run(`Pointer`, func(t *testing.T) {
+		// Example document from RFC 6901 section 5.
+		src := gt.Raw(`{
+			"foo": ["bar", "baz"],
+			"": 0,
+			"a/b": 1,
+			"c%d": 2,
+			"e^f": 3,
+			"g|h": 4,
+			"i\\j": 5,
+			"k\"l": 6,
+			" ": 7,
+			"m~n": 8
+		}`)
+
+		test := func(exp string, ptr string) {
+			t.Helper()
+			val, ok := src.Pointer(ptr)
+			eq(true, ok)
+			eq(exp, val.String())
+		}
+
+		none := func(src gt.Raw, ptr string) {
+			t.Helper()
+			val, ok := src.Pointer(ptr)
+			eq(false, ok)
+			eq(gt.Raw(nil), val)
+		}
+
+		test(`["bar", "baz"]`, `/foo`)
+		test(`"bar"`, `/foo/0`)
+		test(`"baz"`, `/foo/1`)
+		test(`0`, `/`)
+		test(`1`, `/a~1b`)
+		test(`2`, `/c%d`)
+		test(`3`, `/e^f`)
+		test(`4`, `/g|h`)
+		test(`5`, `/i\j`)
+		test(`6`, `/k"l`)
+		test(`7`, `/ `)
+		test(`8`, `/m~0n`)
+
+		eq(`true`, tryRawPointer(gt.Raw(` true `), ``).String())
+		eq(
+			`{"b": [1, {"c": "}]"}]}`,
+			tryRawPointer(gt.Raw(`{"a": {"b": [1, {"c": "}]"}]}}`), `/a`).String(),
+		)
+		eq(`"}]"`, tryRawPointer(gt.Raw(`{"a": {"b": [1, {"c": "}]"}]}}`), `/a/b/1/c`).String())
+		eq(`null`, tryRawPointer(gt.Raw(`[[], {}, null]`), `/2`).String())
+
+		// Duplicate keys: the first match wins.
+		eq(`1`, tryRawPointer(gt.Raw(`{"a": 1, "a": 2}`), `/a`).String())
+
+		none(src, `foo`)
+		none(src, `/missing`)
+		none(src, `/foo/2`)
+		none(src, `/foo/-`)
+		none(src, `/foo/01`)
+		none(src, `/foo/-1`)
+		none(src, `/foo/+1`)
+		none(src, `/foo/one`)
+		none(src, `/foo/0/bar`)
+		none(src, `/m~2n`)
+		none(src, `/m~`)
+		none(nil, ``)
+		none(nil, `/foo`)
+		none(gt.Raw(`{"a": `), `/a`)
+		none(gt.Raw(`{"a" 1}`), `/a`)
+		none(gt.Raw(`[1, `), `/1`)
+		none(gt.Raw(`"str`), ``)
+
+		sub, _ := src.Pointer(`/foo`)
+		sliceShared(src[bytes.IndexByte(src, '['):], sub)
+
+		eq(float64(0), testing.AllocsPerRun(64, func() {
+			_, _ = src.Pointer(`/foo/1`)
+		}))
+	})
+
+	t.Run(`SetPointer`, func(t *testing.T) {
+		test := func(exp string, src gt.Raw, ptr string, val gt.Raw) {
+			t.Helper()
+			prev := string(src)
+			eq(exp, tryRaw(src.SetPointer(ptr, val)).String())
+			eq(prev, string(src))
+		}
+
+		fails := func(msg string, src gt.Raw, ptr string, val gt.Raw) {
+			t.Helper()
+			panics(t, msg, func() { tryRaw(src.SetPointer(ptr, val)) })
+		}
+
+		test(`{"a": 10, "b": [1, 2]}`, gt.Raw(`{"a": 1, "b": [1, 2]}`), `/a`, gt.Raw(`10`))
+		test(`{"a": 1, "b": [1, {"c":3}]}`, gt.Raw(`{"a": 1, "b": [1, 2]}`), `/b/1`, gt.Raw(` {"c":3} `))
+		test(`{"a": 1, "b": [1, 2,3]}`, gt.Raw(`{"a": 1, "b": [1, 2]}`), `/b/2`, gt.Raw(`3`))
+		test(`{"a": 1, "b": [1, 2,3]}`, gt.Raw(`{"a": 1, "b": [1, 2]}`), `/b/-`, gt.Raw(`3`))
+		test(`{"a": 1, "b": [1, 2],"a/b~":true}`, gt.Raw(`{"a": 1, "b": [1, 2]}`), `/a~1b~0`, gt.Raw(`true`))
+		test(`{"<>":"&"}`, gt.Raw(`{}`), `/<>`, gt.Raw(`"&"`))
+		test(`[ "one"]`, gt.Raw(`[ ]`), `/0`, gt.Raw(`"one"`))
+		test(`[ "one"]`, gt.Raw(`[ ]`), `/-`, gt.Raw(`"one"`))
+		test(`[]`, gt.Raw(`{"a": 1}`), ``, gt.Raw(`[]`))
+		test(`{"a": {"b": null}}`, gt.Raw(`{"a": {"b": {"c": 1}}}`), `/a/b`, gt.Raw(`null`))
+
+		fails(`unable to set JSON pointer "/a": value must be valid JSON`, gt.Raw(`{}`), `/a`, gt.Raw(`{`))
+		fails(`value must be valid JSON`, gt.Raw(`{}`), `/a`, nil)
+		fails(`pointer must be empty or start with "/"`, gt.Raw(`{}`), `a`, gt.Raw(`1`))
+		fails(`pointer must be empty or start with "/"`, gt.Raw(`{}`), `/a~2`, gt.Raw(`1`))
+		fails(`parent must be an existing object or array`, gt.Raw(`{}`), `/a/b`, gt.Raw(`1`))
+		fails(`parent must be an existing object or array`, gt.Raw(`{"a": 1}`), `/a/b`, gt.Raw(`1`))
+		fails(`parent must be an existing object or array`, nil, `/a`, gt.Raw(`1`))
+		fails(`array index out of range`, gt.Raw(`[1]`), `/2`, gt.Raw(`1`))
+		fails(`array index out of range`, gt.Raw(`[1]`), `/one`, gt.Raw(`1`))
+	})
+
+	t.Run(`DeletePointer`, func(t *testing.T) {
+		test := func(exp string, src gt.Raw, ptr string) {
+			t.Helper()
+			prev := string(src)
+			val, ok := src.DeletePointer(ptr)
+			eq(true, ok)
+			eq(exp, val.String())
+			eq(prev, string(src))
+		}
+
+		none := func(src gt.Raw, ptr string) {
+			t.Helper()
+			val, ok := src.DeletePointer(ptr)
+			eq(false, ok)
+			eq(gt.Raw(nil), val)
+		}
+
+		test(`{"b": 2, "c": 3}`, gt.Raw(`{"a": 1, "b": 2, "c": 3}`), `/a`)
+		test(`{"a": 1, "c": 3}`, gt.Raw(`{"a": 1, "b": 2, "c": 3}`), `/b`)
+		test(`{"a": 1, "b": 2}`, gt.Raw(`{"a": 1, "b": 2, "c": 3}`), `/c`)
+		test(`{ }`, gt.Raw(`{ "a": 1 }`), `/a`)
+		test(`[2]`, gt.Raw(`[1, 2]`), `/0`)
+		test(`[1]`, gt.Raw(`[1, 2]`), `/1`)
+		test(`{"a": [{"c": 2}]}`, gt.Raw(`{"a": [{"b": 1, "c": 2}]}`), `/a/0/b`)
+		test(``, gt.Raw(`{}`), ``)
+
+		none(gt.Raw(`{"a": 1}`), `/b`)
+		none(gt.Raw(`[1]`), `/1`)
+		none(gt.Raw(`[1]`), `/-`)
+		none(gt.Raw(`{"a": 1}`), `a`)
+		none(gt.Raw(`{"a": 1}`), `/a/b`)
+		none(nil, ``)
+		none(nil, `/a`)
+	})
+
+	t.Run(`MergePatch`, func(t *testing.T) {
+		test := func(exp, src, patch string) {
+			t.Helper()
+			eq(exp, tryRaw(gt.Raw(src).MergePatch(gt.Raw(patch))).String())
+		}
+
+		// Test cases from RFC 7386 appendix A.
+		test(`{"a":"c"}`, `{"a":"b"}`, `{"a":"c"}`)
+		test(`{"a":"b","b":"c"}`, `{"a":"b"}`, `{"b":"c"}`)
+		test(`{}`, `{"a":"b"}`, `{"a":null}`)
+		test(`{"b":"c"}`, `{"a":"b","b":"c"}`, `{"a":null}`)
+		test(`{"a":"c"}`, `{"a":["b"]}`, `{"a":"c"}`)
+		test(`{"a":["b"]}`, `{"a":"c"}`, `{"a":["b"]}`)
+		test(`{"a":{"b":"d"}}`, `{"a":{"b":"c"}}`, `{"a":{"b":"d","c":null}}`)
+		test(`{"a":[1]}`, `{"a":[{"b":"c"}]}`, `{"a":[1]}`)
+		test(`["c","d"]`, `["a","b"]`, `["c","d"]`)
+		test(`["c"]`, `{"a":"b"}`, `["c"]`)
+		test(``, `{"a":"foo"}`, `null`)
+		test(`"bar"`, `{"a":"foo"}`, `"bar"`)
+		test(`{"e":null,"a":1}`, `{"e":null}`, `{"a":1}`)
+		test(`{"a":{"bb":{}}}`, `[1,2]`, `{"a":{"bb":{"ccc":null}}}`)
+		test(`{"a":"b"}`, `{}`, `{"a":"b"}`)
+		test(`{"a":"b"}`, ``, `{"a":"b"}`)
+		test(``, `{"a":"b"}`, ``)
+
+		// Unaffected values are copied as-is.
+		test(`{"a":[1, 2],"b":3}`, ` {"a": [1, 2], "b": 2} `, `{"b": 3}`)
+
+		panics(t, `unable to decode "{" into JSON`, func() { tryRaw(gt.Raw(`{`).MergePatch(gt.Raw(`{}`))) })
+		panics(t, `unable to decode "{" into JSON`, func() { tryRaw(gt.Raw(`{}`).MergePatch(gt.Raw(`{`))) })
+	})
+}
+
+func tryRawPointer(src gt.Raw, ptr string) gt.Raw {
+	val, ok := src.Pointer(ptr)
+	if !ok {
+		panic(fmt.Errorf(`JSON pointer %q not found`, ptr))
+	}
+	return val
+}
+
+func tryRaw(val gt.Raw, err error) gt.Raw {
+	try(err)
+	return val
 }
@@ -0,0 +1,729 @@
+package gt
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+/*
+Frequency of a `gt.Recurrence`, corresponding to the `FREQ` part of an RFC 5545
+recurrence rule. Zero value means "unspecified" and is only valid for a zero
+`gt.Recurrence`. Lower values are coarser.
+*/
+type RecurrenceFreq byte
+
+const (
+	RecurrenceYearly RecurrenceFreq = iota + 1
+	RecurrenceMonthly
+	RecurrenceWeekly
+	RecurrenceDaily
+	RecurrenceHourly
+	RecurrenceMinutely
+	RecurrenceSecondly
+)
+
+var recurrenceFreqNames = [...]string{
+	RecurrenceYearly:   `YEARLY`,
+	RecurrenceMonthly:  `MONTHLY`,
+	RecurrenceWeekly:   `WEEKLY`,
+	RecurrenceDaily:    `DAILY`,
+	RecurrenceHourly:   `HOURLY`,
+	RecurrenceMinutely: `MINUTELY`,
+	RecurrenceSecondly: `SECONDLY`,
+}
+
+// Implement `fmt.Stringer`, returning the RFC 5545 name such as "WEEKLY".
+func (self RecurrenceFreq) String() string {
+	if int(self) < len(recurrenceFreqNames) {
+		return recurrenceFreqNames[self]
+	}
+	return ``
+}
+
+/*
+Element of `gt.Recurrence.ByDay`, corresponding to one entry of the `BYDAY`
+part of an RFC 5545 recurrence rule, such as "MO", "1FR" or "-1SU". `Nth` is
+the optional ordinal: 0 means every such weekday in the period, positive values
+count from the start, negative values count from the end. Ordinals are allowed
+only for `gt.RecurrenceMonthly` and `gt.RecurrenceYearly`.
+*/
+type RecurrenceDay struct {
+	Nth     int          `json:"nth"     db:"nth"`
+	Weekday time.Weekday `json:"weekday" db:"weekday"`
+}
+
+var recurrenceDayNames = [...]string{
+	time.Sunday:    `SU`,
+	time.Monday:    `MO`,
+	time.Tuesday:   `TU`,
+	time.Wednesday: `WE`,
+	time.Thursday:  `TH`,
+	time.Friday:    `FR`,
+	time.Saturday:  `SA`,
+}
+
+// Implement `fmt.Stringer`, returning the RFC 5545 representation.
+func (self RecurrenceDay) String() string {
+	return bytesString(self.AppendTo(nil))
+}
+
+// Implement `gt.AppenderTo`, using the same representation as `.String`.
+func (self RecurrenceDay) AppendTo(buf []byte) []byte {
+	if self.Nth != 0 {
+		buf = strconv.AppendInt(buf, int64(self.Nth), 10)
+	}
+	return append(buf, recurrenceWeekdayName(self.Weekday)...)
+}
+
+/*
+Shortcut: parses successfully or panics. Should be used only in root scope. When
+error handling is relevant, use `.Parse`.
+*/
+func ParseRecurrence(src string) (val Recurrence) {
+	try(val.Parse(src))
+	return
+}
+
+/*
+Recurrence rule as defined by RFC 5545 (iCalendar), section 3.3.10. Text
+representation is the value of an `RRULE` property:
+
+	FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,WE;COUNT=10
+
+Zero value is considered empty in text, and null in JSON and SQL. Features:
+
+  - Reversible encoding/decoding in text. Zero value is "".
+  - Reversible encoding/decoding in JSON. Zero value is `null`.
+  - Reversible encoding/decoding in SQL. Zero value is `null`.
+  - Decoding validates the rule, including the combinations of `BYxxx` parts
+    forbidden by the RFC.
+  - Expansion into `gt.NullTime` or `gt.NullDate` occurrences via `.Each`,
+    `.Between` and `.DatesBetween`.
+
+The rule doesn't include `DTSTART`; it's provided separately when expanding.
+Occurrences are computed in the wall-clock time of the start's location, which
+means that across DST transitions, the local time of day is preserved, just
+like in calendar applications.
+
+When parsing, a missing `INTERVAL` is stored as 1 and a missing `WKST` as
+Monday, which is the RFC default. When encoding, both are omitted if they have
+these default values. Note that the zero value of `time.Weekday` is Sunday, so
+when constructing the struct directly, set `.Wkst` explicitly.
+
+Limitations:
+
+  - `BYWEEKNO` matches only days within the same calendar year.
+  - Leap seconds (`BYSECOND=60`) are accepted but never occur. A `BYSECOND`
+    list with no other values is rejected, because the rule would never match.
+  - Non-standard `X-` parts are rejected.
+*/
+type Recurrence struct {
+	Freq       RecurrenceFreq  `json:"freq"       db:"freq"`
+	Until      NullTime        `json:"until"      db:"until"`
+	Count      int             `json:"count"      db:"count"`
+	Interval   int             `json:"interval"   db:"interval"`
+	BySecond   []int           `json:"bySecond"   db:"by_second"`
+	ByMinute   []int           `json:"byMinute"   db:"by_minute"`
+	ByHour     []int           `json:"byHour"     db:"by_hour"`
+	ByDay      []RecurrenceDay `json:"byDay"      db:"by_day"`
+	ByMonthDay []int           `json:"byMonthDay" db:"by_month_day"`
+	ByYearDay  []int           `json:"byYearDay"  db:"by_year_day"`
+	ByWeekNo   []int           `json:"byWeekNo"   db:"by_week_no"`
+	ByMonth    []int           `json:"byMonth"    db:"by_month"`
+	BySetPos   []int           `json:"bySetPos"   db:"by_set_pos"`
+	Wkst       time.Weekday    `json:"wkst"       db:"wkst"`
+
+	/**
+	Remembers the textual form of `UNTIL` for reversible encoding. Floating
+	timestamps and dates are stored as UTC wall-clock time, and reinterpreted in
+	the start's location during expansion.
+	*/
+	untilForm recurrenceUntilForm
+}
+
+type recurrenceUntilForm byte
+
+const (
+	recurrenceUntilInstant recurrenceUntilForm = iota
+	recurrenceUntilFloating
+	recurrenceUntilDate
+)
+
+const (
+	recurrenceUntilInstantFormat  = `20060102T150405Z`
+	recurrenceUntilFloatingFormat = `20060102T150405`
+	recurrenceUntilDateFormat     = `20060102`
+)
+
+var (
+	_ = Encodable(Recurrence{})
+	_ = Decodable((*Recurrence)(nil))
+)
+
+// Implement `gt.Zeroable`. True if `.Freq` is unspecified.
+func (self Recurrence) IsZero() bool { return self.Freq == 0 }
+
+// Implement `gt.Nullable`. True if zero.
+func (self Recurrence) IsNull() bool { return self.IsZero() }
+
+/*
+Implement `gt.Getter`. If zero, returns `nil`, otherwise uses `.String` to
+return a string representation.
+*/
+func (self Recurrence) Get() any {
+	if self.IsNull() {
+		return nil
+	}
+	return self.String()
+}
+
+// Implement `gt.Setter`, using `.Scan`. Panics on error.
+func (self *Recurrence) Set(src any) { try(self.Scan(src)) }
+
+// Implement `gt.Zeroer`, zeroing the receiver.
+func (self *Recurrence) Zero() {
+	if self != nil {
+		*self = Recurrence{}
+	}
+}
+
+/*
+Implement `fmt.Stringer`. If zero, returns an empty string. Otherwise returns
+the RFC 5545 `RRULE` value, without the "RRULE:" prefix.
+*/
+func (self Recurrence) String() string {
+	if self.IsNull() {
+		return ``
+	}
+	return bytesString(self.AppendTo(nil))
+}
+
+/*
+Implement `gt.Parser`. If the input is empty, zeroes the receiver. Otherwise
+parses an RFC 5545 `RRULE` value, optionally prefixed with "RRULE:". Parsing is
+case-insensitive. The resulting rule is validated via `.Validate`.
+*/
+func (self *Recurrence) Parse(src string) (err error) {
+	if len(src) <= 0 {
+		self.Zero()
+		return nil
+	}
+
+	defer errParse(&err, src, `recurrence`)
+
+	var buf Recurrence
+	err = buf.parse(strings.ToUpper(src))
+	if err != nil {
+		return err
+	}
+
+	err = buf.Validate()
+	if err != nil {
+		return err
+	}
+
+	*self = buf
+	return nil
+}
+
+// Implement `gt.AppenderTo`, using the same representation as `.String`.
+func (self Recurrence) AppendTo(buf []byte) []byte {
+	if self.IsNull() {
+		return buf
+	}
+
+	buf = append(buf, `FREQ=`...)
+	buf = append(buf, self.Freq.String()...)
+
+	if !self.Until.IsZero() {
+		buf = append(buf, `;UNTIL=`...)
+		buf = self.appendUntil(buf)
+	}
+	if self.Count > 0 {
+		buf = append(buf, `;COUNT=`...)
+		buf = strconv.AppendInt(buf, int64(self.Count), 10)
+	}
+	if self.Interval > 1 {
+		buf = append(buf, `;INTERVAL=`...)
+		buf = strconv.AppendInt(buf, int64(self.Interval), 10)
+	}
+
+	buf = appendRecurrenceInts(buf, `;BYSECOND=`, self.BySecond)
+	buf = appendRecurrenceInts(buf, `;BYMINUTE=`, self.ByMinute)
+	buf = appendRecurrenceInts(buf, `;BYHOUR=`, self.ByHour)
+
+	if len(self.ByDay) > 0 {
+		buf = append(buf, `;BYDAY=`...)
+		for ind, val := range self.ByDay {
+			if ind > 0 {
+				buf = append(buf, ',')
+			}
+			buf = val.AppendTo(buf)
+		}
+	}
+
+	buf = appendRecurrenceInts(buf, `;BYMONTHDAY=`, self.ByMonthDay)
+	buf = appendRecurrenceInts(buf, `;BYYEARDAY=`, self.ByYearDay)
+	buf = appendRecurrenceInts(buf, `;BYWEEKNO=`, self.ByWeekNo)
+	buf = appendRecurrenceInts(buf, `;BYMONTH=`, self.ByMonth)
+	buf = appendRecurrenceInts(buf, `;BYSETPOS=`, self.BySetPos)
+
+	if self.Wkst != time.Monday {
+		buf = append(buf, `;WKST=`...)
+		buf = append(buf, recurrenceWeekdayName(self.Wkst)...)
+	}
+	return buf
+}
+
+/*
+Implement `encoding.TextMarhaler`. If zero, returns nil. Otherwise returns the
+same representation as `.String`.
+*/
+func (self Recurrence) MarshalText() ([]byte, error) {
+	if self.IsNull() {
+		return nil, nil
+	}
+	return self.AppendTo(nil), nil
+}
+
+// Implement `encoding.TextUnmarshaler`, using the same algorithm as `.Parse`.
+func (self *Recurrence) UnmarshalText(src []byte) error {
+	return self.Parse(bytesString(src))
+}
+
+/*
+Implement `json.Marshaler`. If zero, returns bytes representing `null`.
+Otherwise returns bytes representing a JSON string with the same text as in
+`.String`.
+*/
+func (self Recurrence) MarshalJSON() ([]byte, error) {
+	if self.IsNull() {
+		return bytesNull, nil
+	}
+
+	var buf []byte
+	buf = append(buf, '"')
+	buf = self.AppendTo(buf)
+	buf = append(buf, '"')
+	return buf, nil
+}
+
+/*
+Implement `json.Unmarshaler`. If the input is empty or represents JSON `null`,
+zeroes the receiver. Otherwise parses a JSON string, using the same algorithm
+as `.Parse`.
+*/
+func (self *Recurrence) UnmarshalJSON(src []byte) error {
+	if isJsonEmpty(src) {
+		self.Zero()
+		return nil
+	}
+
+	if isJsonStr(src) {
+		return self.UnmarshalText(cutJsonStr(src))
+	}
+
+	return errJsonString(src, self)
+}
+
+// Implement `driver.Valuer`, using `.Get`.
+func (self Recurrence) Value() (driver.Value, error) {
+	return self.Get(), nil
+}
+
+/*
+Implement `sql.Scanner`, converting an arbitrary input to `gt.Recurrence` and
+modifying the receiver. Acceptable inputs:
+
+  - `nil`           -> use `.Zero`
+  - `string`        -> use `.Parse`
+  - `[]byte`        -> use `.UnmarshalText`
+  - `gt.Recurrence` -> assign
+  - `gt.Getter`     -> scan underlying value
+*/
+func (self *Recurrence) Scan(src any) error {
+	switch src := src.(type) {
+	case nil:
+		self.Zero()
+		return nil
+
+	case string:
+		return self.Parse(src)
+
+	case []byte:
+		return self.UnmarshalText(src)
+
+	case Recurrence:
+		*self = src
+		return nil
+
+	default:
+		val, ok := get(src)
+		if ok {
+			return self.Scan(val)
+		}
+		return errScanType(self, src)
+	}
+}
+
+/*
+Returns an error if the rule violates RFC 5545: unspecified frequency, values
+out of range, both `COUNT` and `UNTIL`, or `BYxxx` parts that are not allowed
+for the given frequency. A zero rule is considered valid.
+*/
+func (self Recurrence) Validate() error {
+	if self.IsZero() {
+		return nil
+	}
+
+	freq := self.Freq
+	if int(freq) >= len(recurrenceFreqNames) {
+		return fmt.Errorf(`[gt] invalid recurrence frequency %v`, int(freq))
+	}
+	if self.Count < 0 {
+		return fmt.Errorf(`[gt] invalid recurrence count %v`, self.Count)
+	}
+	if self.Interval < 0 {
+		return fmt.Errorf(`[gt] invalid recurrence interval %v`, self.Interval)
+	}
+	if self.Count > 0 && !self.Until.IsZero() {
+		return fmt.Errorf(`[gt] recurrence must not have both COUNT and UNTIL`)
+	}
+	if self.Wkst < 0 || int(self.Wkst) >= len(recurrenceDayNames) {
+		return fmt.Errorf(`[gt] invalid recurrence week start %v`, int(self.Wkst))
+	}
+
+	for _, err := range [...]error{
+		validRecurrenceInts(`BYSECOND`, self.BySecond, 0, 60, false),
+		validRecurrenceInts(`BYMINUTE`, self.ByMinute, 0, 59, false),
+		validRecurrenceInts(`BYHOUR`, self.ByHour, 0, 23, false),
+		validRecurrenceInts(`BYMONTHDAY`, self.ByMonthDay, 1, 31, true),
+		validRecurrenceInts(`BYYEARDAY`, self.ByYearDay, 1, 366, true),
+		validRecurrenceInts(`BYWEEKNO`, self.ByWeekNo, 1, 53, true),
+		validRecurrenceInts(`BYMONTH`, self.ByMonth, 1, 12, false),
+		validRecurrenceInts(`BYSETPOS`, self.BySetPos, 1, 366, true),
+	} {
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(self.BySecond) > 0 && !recurrenceHasSecond(self.BySecond) {
+		return fmt.Errorf(`[gt] recurrence BYSECOND must include a value other than 60, because leap seconds never occur`)
+	}
+
+	for _, val := range self.ByDay {
+		if int(val.Weekday) >= len(recurrenceDayNames) || val.Weekday < 0 {
+			return fmt.Errorf(`[gt] invalid recurrence weekday %v`, int(val.Weekday))
+		}
+		if val.Nth < -53 || val.Nth > 53 {
+			return fmt.Errorf(`[gt] recurrence BYDAY ordinal %v out of range`, val.Nth)
+		}
+		if val.Nth != 0 && (freq != RecurrenceMonthly && freq != RecurrenceYearly || len(self.ByWeekNo) > 0) {
+			return fmt.Errorf(`[gt] recurrence BYDAY ordinal is allowed only for MONTHLY or YEARLY without BYWEEKNO`)
+		}
+	}
+
+	if len(self.ByMonthDay) > 0 && freq == RecurrenceWeekly {
+		return fmt.Errorf(`[gt] recurrence BYMONTHDAY is not allowed for WEEKLY`)
+	}
+	if len(self.ByYearDay) > 0 && (freq == RecurrenceMonthly || freq == RecurrenceWeekly || freq == RecurrenceDaily) {
+		return fmt.Errorf(`[gt] recurrence BYYEARDAY is not allowed for %v`, freq)
+	}
+	if len(self.ByWeekNo) > 0 && freq != RecurrenceYearly {
+		return fmt.Errorf(`[gt] recurrence BYWEEKNO is allowed only for YEARLY`)
+	}
+	if len(self.BySetPos) > 0 && !self.hasBy() {
+		return fmt.Errorf(`[gt] recurrence BYSETPOS requires another BYxxx part`)
+	}
+	return nil
+}
+
+func (self Recurrence) hasBy() bool {
+	return len(self.BySecond) > 0 ||
+		len(self.ByMinute) > 0 ||
+		len(self.ByHour) > 0 ||
+		len(self.ByDay) > 0 ||
+		len(self.ByMonthDay) > 0 ||
+		len(self.ByYearDay) > 0 ||
+		len(self.ByWeekNo) > 0 ||
+		len(self.ByMonth) > 0
+}
+
+func (self *Recurrence) parse(src string) error {
+	src = strings.TrimPrefix(src, `RRULE:`)
+	self.Interval = 1
+	self.Wkst = time.Monday
+
+	var seen []string
+
+	for len(src) > 0 {
+		var part string
+		part, src = cutRecurrencePart(src, ';')
+
+		key, val, ok := strings.Cut(part, `=`)
+		if !ok || len(val) <= 0 {
+			return fmt.Errorf(`malformed part %q`, part)
+		}
+
+		for _, prev := range seen {
+			if prev == key {
+				return fmt.Errorf(`duplicate part %q`, key)
+			}
+		}
+		seen = append(seen, key)
+
+		err := self.parsePart(key, val)
+		if err != nil {
+			return fmt.Errorf(`invalid part %q: %w`, part, err)
+		}
+	}
+
+	if self.Freq == 0 {
+		return fmt.Errorf(`missing FREQ`)
+	}
+	return nil
+}
+
+func (self *Recurrence) parsePart(key, val string) (err error) {
+	switch key {
+	case `FREQ`:
+		for ind, name := range recurrenceFreqNames {
+			if ind > 0 && name == val {
+				self.Freq = RecurrenceFreq(ind)
+				return nil
+			}
+		}
+		return errFormatMismatch
+
+	case `UNTIL`:
+		return self.parseUntil(val)
+
+	case `COUNT`:
+		self.Count, err = parseRecurrencePositive(val)
+		return
+
+	case `INTERVAL`:
+		self.Interval, err = parseRecurrencePositive(val)
+		return
+
+	case `BYSECOND`:
+		self.BySecond, err = parseRecurrenceInts(val)
+		return
+
+	case `BYMINUTE`:
+		self.ByMinute, err = parseRecurrenceInts(val)
+		return
+
+	case `BYHOUR`:
+		self.ByHour, err = parseRecurrenceInts(val)
+		return
+
+	case `BYDAY`:
+		self.ByDay, err = parseRecurrenceDays(val)
+		return
+
+	case `BYMONTHDAY`:
+		self.ByMonthDay, err = parseRecurrenceInts(val)
+		return
+
+	case `BYYEARDAY`:
+		self.ByYearDay, err = parseRecurrenceInts(val)
+		return
+
+	case `BYWEEKNO`:
+		self.ByWeekNo, err = parseRecurrenceInts(val)
+		return
+
+	case `BYMONTH`:
+		self.ByMonth, err = parseRecurrenceInts(val)
+		return
+
+	case `BYSETPOS`:
+		self.BySetPos, err = parseRecurrenceInts(val)
+		return
+
+	case `WKST`:
+		self.Wkst, err = parseRecurrenceWeekday(val)
+		return
+
+	default:
+		return fmt.Errorf(`unrecognized part`)
+	}
+}
+
+func (self *Recurrence) parseUntil(src string) error {
+	var form recurrenceUntilForm
+	var layout string
+
+	switch len(src) {
+	case len(recurrenceUntilInstantFormat):
+		form, layout = recurrenceUntilInstant, recurrenceUntilInstantFormat
+	case len(recurrenceUntilFloatingFormat):
+		form, layout = recurrenceUntilFloating, recurrenceUntilFloatingFormat
+	case len(recurrenceUntilDateFormat):
+		form, layout = recurrenceUntilDate, recurrenceUntilDateFormat
+	default:
+		return errUnrecLength
+	}
+
+	val, err := time.Parse(layout, src)
+	if err != nil {
+		return err
+	}
+
+	self.Until = NullTime(val)
+	self.untilForm = form
+	return nil
+}
+
+func (self Recurrence) appendUntil(buf []byte) []byte {
+	switch self.untilForm {
+	case recurrenceUntilFloating:
+		return self.Until.AppendFormat(buf, recurrenceUntilFloatingFormat)
+	case recurrenceUntilDate:
+		return self.Until.AppendFormat(buf, recurrenceUntilDateFormat)
+	default:
+		return self.Until.UTC().AppendFormat(buf, recurrenceUntilInstantFormat)
+	}
+}
+
+/*
+Returns the upper bound for occurrences. Floating and date-only forms of
+`UNTIL` are interpreted as wall-clock time in the given location.
+*/
+func (self Recurrence) untilIn(loc *time.Location) time.Time {
+	if self.untilForm == recurrenceUntilInstant {
+		return self.Until.Time()
+	}
+
+	val := self.Until
+	year, month, day := val.Date()
+	hour, min, sec := val.Clock()
+
+	if self.untilForm == recurrenceUntilDate {
+		// Dates are inclusive: the entire day is allowed.
+		hour, min, sec = 23, 59, 59
+	}
+	return time.Date(year, month, day, hour, min, sec, int(time.Second-1), loc)
+}
+
+func cutRecurrencePart(src string, sep byte) (string, string) {
+	ind := strings.IndexByte(src, sep)
+	if ind < 0 {
+		return src, ``
+	}
+	return src[:ind], src[ind+1:]
+}
+
+func parseRecurrencePositive(src string) (int, error) {
+	val, err := strconv.Atoi(src)
+	if err != nil {
+		return 0, err
+	}
+	if val <= 0 {
+		return 0, fmt.Errorf(`expected positive integer, got %v`, val)
+	}
+	return val, nil
+}
+
+func parseRecurrenceInts(src string) (out []int, err error) {
+	for len(src) > 0 {
+		var part string
+		part, src = cutRecurrencePart(src, ',')
+
+		val, err := strconv.Atoi(part)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, val)
+	}
+	return
+}
+
+func parseRecurrenceDays(src string) (out []RecurrenceDay, err error) {
+	for len(src) > 0 {
+		var part string
+		part, src = cutRecurrencePart(src, ',')
+
+		if len(part) < 2 {
+			return nil, errUnrecLength
+		}
+
+		var val RecurrenceDay
+		pre, suf := part[:len(part)-2], part[len(part)-2:]
+
+		if len(pre) > 0 {
+			val.Nth, err = strconv.Atoi(pre)
+			if err != nil {
+				return nil, err
+			}
+			if val.Nth == 0 {
+				return nil, fmt.Errorf(`invalid BYDAY ordinal %q`, pre)
+			}
+		}
+
+		val.Weekday, err = parseRecurrenceWeekday(suf)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, val)
+	}
+	return
+}
+
+func parseRecurrenceWeekday(src string) (time.Weekday, error) {
+	for ind, name := range recurrenceDayNames {
+		if name == src {
+			return time.Weekday(ind), nil
+		}
+	}
+	return 0, fmt.Errorf(`unrecognized weekday %q`, src)
+}
+
+func recurrenceWeekdayName(val time.Weekday) string {
+	if val >= 0 && int(val) < len(recurrenceDayNames) {
+		return recurrenceDayNames[val]
+	}
+	return ``
+}
+
+func appendRecurrenceInts(buf []byte, prefix string, src []int) []byte {
+	if len(src) <= 0 {
+		return buf
+	}
+	buf = append(buf, prefix...)
+	for ind, val := range src {
+		if ind > 0 {
+			buf = append(buf, ',')
+		}
+		buf = strconv.AppendInt(buf, int64(val), 10)
+	}
+	return buf
+}
+
+// True if any of the values is a second that can occur, excluding leap seconds.
+func recurrenceHasSecond(src []int) bool {
+	for _, val := range src {
+		if val >= 0 && val < 60 {
+			return true
+		}
+	}
+	return false
+}
+
+func validRecurrenceInts(name string, src []int, min, max int, neg bool) error {
+	for _, val := range src {
+		abs := val
+		if neg && val < 0 {
+			abs = -val
+		}
+		if abs < min || abs > max {
+			return fmt.Errorf(`[gt] recurrence %v value %v out of range`, name, val)
+		}
+	}
+	return nil
+}
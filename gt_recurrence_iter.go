@@ -0,0 +1,521 @@
+package gt
+
+import (
+	"sort"
+	"time"
+)
+
+/*
+Max year for recurrence expansion. Guarantees termination for unbounded rules
+and for rules that never match, such as "FREQ=YEARLY;BYMONTH=2;BYMONTHDAY=30".
+Matches the max year allowed by RFC 5545.
+*/
+const recurrenceMaxYear = 9999
+
+/*
+Max product of period index and interval. Exceeds the number of seconds between
+the years 0 and 9999, so any period past this limit is also past the max year.
+Prevents overflow of period arithmetic.
+*/
+const recurrenceMaxStep = 1 << 40
+
+/*
+Calls the function for each occurrence of the rule, starting at the given
+`DTSTART`, in ascending order, until the function returns false or the rule is
+exhausted via `COUNT`, `UNTIL`, or the year 9999. Occurrences are computed in
+wall-clock time of `start.Location()`; use `gt.NullTime.In` to choose the
+location. Like in most implementations, the start itself is included only if it
+matches the rule. Does nothing if the rule or the start is zero.
+*/
+func (self Recurrence) Each(start NullTime, fun func(NullTime) bool) {
+	if self.IsZero() || start.IsZero() || fun == nil {
+		return
+	}
+
+	iter := newRecurrenceIter(self, start.Time())
+	until := time.Time{}
+	if !self.Until.IsZero() {
+		until = self.untilIn(iter.loc)
+	}
+
+	var count int
+	for ind := 0; ; ind++ {
+		ind = iter.fill(ind)
+		if ind < 0 {
+			return
+		}
+
+		for _, val := range iter.buf {
+			inst := iter.inst(val)
+			if inst.Before(iter.start) {
+				continue
+			}
+			if !until.IsZero() && inst.After(until) {
+				return
+			}
+			if !fun(NullTime(inst)) {
+				return
+			}
+			count++
+			if self.Count > 0 && count >= self.Count {
+				return
+			}
+		}
+	}
+}
+
+/*
+Returns the occurrences of the rule, starting at the given `DTSTART`, within
+the inclusive bounds `min` and `max`, skipping the excluded timestamps, which
+correspond to `EXDATE`. Zero bounds are considered unbounded. Excluded
+occurrences still count towards `COUNT`, as required by RFC 5545. See `.Each`
+for additional details.
+
+Caution: if the rule has neither `COUNT` nor `UNTIL` and `max` is zero, the
+rule is expanded until the year 9999.
+*/
+func (self Recurrence) Between(start, min, max NullTime, exclude ...NullTime) (out []NullTime) {
+	self.Each(start, func(val NullTime) bool {
+		if !max.IsZero() && val.After(max) {
+			return false
+		}
+		if (min.IsZero() || !val.Before(min)) && !recurrenceExcludes(exclude, val) {
+			out = append(out, val)
+		}
+		return true
+	})
+	return
+}
+
+/*
+Date-only version of `.Between`, where `DTSTART`, bounds, exclusions and
+occurrences are civil dates. Dates are expanded in UTC, which has no DST.
+For sub-daily frequencies, each date is returned at most once.
+*/
+func (self Recurrence) DatesBetween(start, min, max NullDate, exclude ...NullDate) (out []NullDate) {
+	self.Each(start.NullTimeUTC(), func(val NullTime) bool {
+		date := val.NullDate()
+		if !max.IsZero() && date.After(max) {
+			return false
+		}
+		if !min.IsZero() && date.Before(min) {
+			return true
+		}
+		if len(out) > 0 && out[len(out)-1] == date {
+			return true
+		}
+		for _, val := range exclude {
+			if val == date {
+				return true
+			}
+		}
+		out = append(out, date)
+		return true
+	})
+	return
+}
+
+func recurrenceExcludes(src []NullTime, val NullTime) bool {
+	for _, src := range src {
+		if src.Equal(val) {
+			return true
+		}
+	}
+	return false
+}
+
+/*
+Internal state of recurrence expansion. All calculations are performed on
+"naive" wall-clock timestamps represented as `time.Time` in UTC, which has no
+DST. Occurrences are converted to the target location at the very end.
+*/
+type recurrenceIter struct {
+	rule  Recurrence
+	loc   *time.Location
+	start time.Time
+	naive time.Time
+	week  time.Time
+	buf   []time.Time
+	never bool
+}
+
+func newRecurrenceIter(rule Recurrence, start time.Time) *recurrenceIter {
+	self := &recurrenceIter{
+		loc:   start.Location(),
+		start: start,
+		naive: time.Date(start.Year(), start.Month(), start.Day(), start.Hour(), start.Minute(), start.Second(), start.Nanosecond(), time.UTC),
+	}
+
+	if rule.Interval <= 0 {
+		rule.Interval = 1
+	}
+
+	freq := rule.Freq
+	none := len(rule.ByDay) == 0 && len(rule.ByMonthDay) == 0 && len(rule.ByYearDay) == 0 && len(rule.ByWeekNo) == 0
+
+	switch {
+	case freq == RecurrenceYearly && none && len(rule.ByMonth) == 0:
+		rule.ByMonth = []int{int(start.Month())}
+		rule.ByMonthDay = []int{start.Day()}
+	case freq == RecurrenceYearly && none:
+		rule.ByMonthDay = []int{start.Day()}
+	case freq == RecurrenceYearly && len(rule.ByWeekNo) > 0 && len(rule.ByDay) == 0 && len(rule.ByMonthDay) == 0 && len(rule.ByYearDay) == 0:
+		rule.ByDay = []RecurrenceDay{{Weekday: start.Weekday()}}
+	case freq == RecurrenceMonthly && len(rule.ByDay) == 0 && len(rule.ByMonthDay) == 0 && len(rule.ByYearDay) == 0:
+		rule.ByMonthDay = []int{start.Day()}
+	case freq == RecurrenceWeekly && len(rule.ByDay) == 0:
+		rule.ByDay = []RecurrenceDay{{Weekday: start.Weekday()}}
+	}
+
+	if freq < RecurrenceHourly && len(rule.ByHour) == 0 {
+		rule.ByHour = []int{start.Hour()}
+	}
+	if freq < RecurrenceMinutely && len(rule.ByMinute) == 0 {
+		rule.ByMinute = []int{start.Minute()}
+	}
+	if freq < RecurrenceSecondly && len(rule.BySecond) == 0 {
+		rule.BySecond = []int{start.Second()}
+	}
+
+	rule.ByHour = sortedInts(rule.ByHour)
+	rule.ByMinute = sortedInts(rule.ByMinute)
+	rule.BySecond = sortedInts(rule.BySecond)
+
+	// Leap seconds are not representable. Without other seconds, the rule never
+	// matches, and `.fill` would otherwise step towards the max year one period
+	// at a time. This applies only to rules that skip validation.
+	self.never = len(rule.BySecond) > 0 && !recurrenceHasSecond(rule.BySecond)
+
+	self.rule = rule
+	self.week = self.naive.AddDate(0, 0, -int((7+self.naive.Weekday()-rule.Wkst)%7))
+	self.week = time.Date(self.week.Year(), self.week.Month(), self.week.Day(), 0, 0, 0, 0, time.UTC)
+	return self
+}
+
+/*
+Converts a naive wall-clock time to the target location. For wall-clock times
+skipped by a DST transition, RFC 5545 requires the UTC offset in effect before
+the transition, which `time.Date` doesn't guarantee.
+*/
+func (self *recurrenceIter) inst(val time.Time) time.Time {
+	out := time.Date(val.Year(), val.Month(), val.Day(), val.Hour(), val.Minute(), val.Second(), val.Nanosecond(), self.loc)
+	if out.Hour() == val.Hour() && out.Minute() == val.Minute() {
+		return out
+	}
+
+	// Transitions are hours apart at most, and months apart at least.
+	_, off := out.Add(-3 * time.Hour).Zone()
+	return time.Unix(val.Unix()-int64(off), int64(val.Nanosecond())).In(self.loc)
+}
+
+/*
+Fills the buffer with the occurrences of the period at the given index, or at
+the next index that has any occurrences. Returns the index of the filled
+period, or -1 if the rule is exhausted.
+*/
+func (self *recurrenceIter) fill(ind int) int {
+	self.buf = self.buf[:0]
+	if self.never {
+		return -1
+	}
+
+	for ; ; ind++ {
+		from, till, ok := self.period(ind)
+		if !ok {
+			return -1
+		}
+
+		for day := from; day.Before(till); day = day.AddDate(0, 0, 1) {
+			if self.matchDay(day) {
+				self.fillDay(day, ind)
+			}
+		}
+
+		self.setPos()
+		if len(self.buf) > 0 {
+			return ind
+		}
+
+		// Skip to the next day for sub-daily frequencies where the day failed.
+		if self.rule.Freq > RecurrenceDaily && !self.matchDay(from) {
+			ind = self.nextDayInd(ind, from)
+		}
+	}
+}
+
+/*
+Returns the naive time range of the period at the given index. For frequencies
+of daily or coarser, this range consists of whole days. For finer frequencies,
+it consists of the single day containing the period, and `.fillDay` narrows it
+down via `.clock`.
+*/
+func (self *recurrenceIter) period(ind int) (time.Time, time.Time, bool) {
+	rule := &self.rule
+	step, ok := self.step(ind)
+	if !ok {
+		return time.Time{}, time.Time{}, false
+	}
+	naive := self.naive
+	var from, till time.Time
+
+	switch rule.Freq {
+	case RecurrenceYearly:
+		from = time.Date(naive.Year()+step, 1, 1, 0, 0, 0, 0, time.UTC)
+		till = from.AddDate(1, 0, 0)
+	case RecurrenceMonthly:
+		from = time.Date(naive.Year(), naive.Month()+time.Month(step), 1, 0, 0, 0, 0, time.UTC)
+		till = from.AddDate(0, 1, 0)
+	case RecurrenceWeekly:
+		from = self.week.AddDate(0, 0, 7*step)
+		till = from.AddDate(0, 0, 7)
+	case RecurrenceDaily:
+		from = time.Date(naive.Year(), naive.Month(), naive.Day()+step, 0, 0, 0, 0, time.UTC)
+		till = from.AddDate(0, 0, 1)
+	default:
+		val := self.clock(ind)
+		from = time.Date(val.Year(), val.Month(), val.Day(), 0, 0, 0, 0, time.UTC)
+		till = from.AddDate(0, 0, 1)
+	}
+
+	return from, till, from.Year() <= recurrenceMaxYear
+}
+
+// Product of the period index and the interval, or false if out of range.
+func (self *recurrenceIter) step(ind int) (int, bool) {
+	if ind < 0 || int64(ind) > recurrenceMaxStep/int64(self.rule.Interval) {
+		return 0, false
+	}
+	return ind * self.rule.Interval, true
+}
+
+/*
+Naive start of the sub-daily period at the given index, which must be within
+`.step` range. Whole days are added separately from the remainder, because the
+total span may exceed the range of `time.Duration`.
+*/
+func (self *recurrenceIter) clock(ind int) time.Time {
+	step, _ := self.step(ind)
+	unit := self.clockUnit()
+	perDay := int(24 * time.Hour / unit)
+
+	return self.naive.Truncate(unit).
+		AddDate(0, 0, step/perDay).
+		Add(time.Duration(step%perDay) * unit)
+}
+
+func (self *recurrenceIter) clockUnit() time.Duration {
+	switch self.rule.Freq {
+	case RecurrenceHourly:
+		return time.Hour
+	case RecurrenceMinutely:
+		return time.Minute
+	default:
+		return time.Second
+	}
+}
+
+// Returns the index preceding the first sub-daily period of the next day.
+func (self *recurrenceIter) nextDayInd(ind int, day time.Time) int {
+	step := self.clockUnit() * time.Duration(self.rule.Interval)
+	diff := day.AddDate(0, 0, 1).Sub(self.clock(ind))
+	return ind + int((diff+step-1)/step) - 1
+}
+
+func (self *recurrenceIter) fillDay(day time.Time, ind int) {
+	rule := &self.rule
+	year, month, date := day.Date()
+	nsec := self.naive.Nanosecond()
+
+	if rule.Freq > RecurrenceDaily {
+		hour, min, sec := self.clock(ind).Clock()
+
+		if rule.Freq == RecurrenceHourly {
+			if allowsInt(rule.ByHour, hour) {
+				self.fillClock(year, month, date, []int{hour}, rule.ByMinute, rule.BySecond, nsec)
+			}
+			return
+		}
+		if rule.Freq == RecurrenceMinutely {
+			if allowsInt(rule.ByHour, hour) && allowsInt(rule.ByMinute, min) {
+				self.fillClock(year, month, date, []int{hour}, []int{min}, rule.BySecond, nsec)
+			}
+			return
+		}
+		if allowsInt(rule.ByHour, hour) && allowsInt(rule.ByMinute, min) && allowsInt(rule.BySecond, sec) {
+			self.fillClock(year, month, date, []int{hour}, []int{min}, []int{sec}, nsec)
+		}
+		return
+	}
+
+	self.fillClock(year, month, date, rule.ByHour, rule.ByMinute, rule.BySecond, nsec)
+}
+
+func (self *recurrenceIter) fillClock(year int, month time.Month, day int, hours, mins, secs []int, nsec int) {
+	for _, hour := range hours {
+		for _, min := range mins {
+			for _, sec := range secs {
+				// Leap seconds are not representable.
+				if sec >= 60 {
+					continue
+				}
+				self.buf = append(self.buf, time.Date(year, month, day, hour, min, sec, nsec, time.UTC))
+			}
+		}
+	}
+}
+
+func (self *recurrenceIter) setPos() {
+	pos := self.rule.BySetPos
+	if len(pos) <= 0 || len(self.buf) <= 0 {
+		return
+	}
+
+	src := append([]time.Time(nil), self.buf...)
+	self.buf = self.buf[:0]
+
+	for _, val := range pos {
+		ind := val - 1
+		if val < 0 {
+			ind = len(src) + val
+		}
+		if ind >= 0 && ind < len(src) {
+			self.buf = append(self.buf, src[ind])
+		}
+	}
+
+	sort.Slice(self.buf, func(one, two int) bool { return self.buf[one].Before(self.buf[two]) })
+
+	out := self.buf[:0]
+	for ind, val := range self.buf {
+		if ind == 0 || !val.Equal(self.buf[ind-1]) {
+			out = append(out, val)
+		}
+	}
+	self.buf = out
+}
+
+func (self *recurrenceIter) matchDay(day time.Time) bool {
+	rule := &self.rule
+	year, month, date := day.Date()
+
+	if len(rule.ByMonth) > 0 && !hasInt(rule.ByMonth, int(month)) {
+		return false
+	}
+
+	if len(rule.ByWeekNo) > 0 {
+		wyear, wnum := recurrenceWeekNo(day, rule.Wkst)
+		if !hasInt(rule.ByWeekNo, wnum) && !hasInt(rule.ByWeekNo, wnum-recurrenceWeeksInYear(wyear, rule.Wkst)-1) {
+			return false
+		}
+	}
+
+	if len(rule.ByYearDay) > 0 {
+		yday := day.YearDay()
+		if !hasInt(rule.ByYearDay, yday) && !hasInt(rule.ByYearDay, yday-daysInYear(year)-1) {
+			return false
+		}
+	}
+
+	if len(rule.ByMonthDay) > 0 {
+		if !hasInt(rule.ByMonthDay, date) && !hasInt(rule.ByMonthDay, date-daysInMonth(year, month)-1) {
+			return false
+		}
+	}
+
+	if len(rule.ByDay) > 0 && !self.matchWeekday(day) {
+		return false
+	}
+	return true
+}
+
+func (self *recurrenceIter) matchWeekday(day time.Time) bool {
+	rule := &self.rule
+	year, month, date := day.Date()
+	weekday := day.Weekday()
+
+	// Ordinals are relative to the year only for yearly rules without months.
+	pos, size := date, daysInMonth(year, month)
+	if rule.Freq == RecurrenceYearly && len(rule.ByMonth) == 0 {
+		pos, size = day.YearDay(), daysInYear(year)
+	}
+
+	for _, val := range rule.ByDay {
+		if val.Weekday != weekday {
+			continue
+		}
+		if val.Nth == 0 ||
+			(val.Nth > 0 && (pos-1)/7+1 == val.Nth) ||
+			(val.Nth < 0 && (size-pos)/7+1 == -val.Nth) {
+			return true
+		}
+	}
+	return false
+}
+
+/*
+Returns the week-numbering year and week number of the given day, where weeks
+start on the given weekday, and week 1 is the first week with at least 4 days
+in the year. For Monday, this is equivalent to `time.Time.ISOWeek`.
+*/
+func recurrenceWeekNo(day time.Time, wkst time.Weekday) (int, int) {
+	year := day.Year()
+	start := recurrenceWeekOne(year, wkst)
+
+	if day.Before(start) {
+		year--
+		start = recurrenceWeekOne(year, wkst)
+	} else if next := recurrenceWeekOne(year+1, wkst); !day.Before(next) {
+		year++
+		start = next
+	}
+	return year, int(day.Sub(start)/(7*24*time.Hour)) + 1
+}
+
+func recurrenceWeeksInYear(year int, wkst time.Weekday) int {
+	return int(recurrenceWeekOne(year+1, wkst).Sub(recurrenceWeekOne(year, wkst)) / (7 * 24 * time.Hour))
+}
+
+func recurrenceWeekOne(year int, wkst time.Weekday) time.Time {
+	jan := time.Date(year, 1, 1, 0, 0, 0, 0, time.UTC)
+	off := int((7 + jan.Weekday() - wkst) % 7)
+	if off <= 3 {
+		return jan.AddDate(0, 0, -off)
+	}
+	return jan.AddDate(0, 0, 7-off)
+}
+
+func daysInMonth(year int, month time.Month) int {
+	return time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
+
+func daysInYear(year int) int {
+	return time.Date(year, 12, 31, 0, 0, 0, 0, time.UTC).YearDay()
+}
+
+// Same as `hasInt`, but an empty list allows any value.
+func allowsInt(src []int, val int) bool {
+	return len(src) <= 0 || hasInt(src, val)
+}
+
+func hasInt(src []int, val int) bool {
+	for _, src := range src {
+		if src == val {
+			return true
+		}
+	}
+	return false
+}
+
+// Returns a sorted copy without duplicates.
+func sortedInts(src []int) []int {
+	buf := append([]int(nil), src...)
+	sort.Ints(buf)
+
+	out := buf[:0]
+	for ind, val := range buf {
+		if ind == 0 || val != buf[ind-1] {
+			out = append(out, val)
+		}
+	}
+	return out
+}
@@ -0,0 +1,217 @@
+package gt_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/mitranim/gt"
+)
+
+func TestRecurrence_common(t *testing.T) {
+	var (
+		primZero    = ``
+		primNonZero = `FREQ=WEEKLY;UNTIL=19971224T000000Z;INTERVAL=2;BYDAY=MO,WE,FR;WKST=SU`
+		textZero    = ``
+		textNonZero = primNonZero
+		jsonZero    = bytesNull
+		jsonNonZero = jsonBytes(textNonZero)
+		zero        = gt.Recurrence{}
+		nonZero     = gt.ParseRecurrence(textNonZero)
+		dec         = new(gt.Recurrence)
+	)
+
+	eq(true, zero.IsNull())
+	eq(false, nonZero.IsNull())
+
+	testAny(t, primZero, primNonZero, textZero, textNonZero, jsonZero, jsonNonZero, zero, nonZero, dec)
+}
+
+func TestRecurrence_Parse(t *testing.T) {
+	test := func(exp gt.Recurrence, src string) {
+		t.Helper()
+		eq(exp, gt.ParseRecurrence(src))
+	}
+
+	test(gt.Recurrence{}, ``)
+
+	test(
+		gt.Recurrence{Freq: gt.RecurrenceDaily, Count: 10, Interval: 1, Wkst: time.Monday},
+		`FREQ=DAILY;COUNT=10`,
+	)
+
+	test(
+		gt.Recurrence{Freq: gt.RecurrenceDaily, Count: 10, Interval: 1, Wkst: time.Monday},
+		`rrule:freq=daily;count=10`,
+	)
+
+	test(
+		gt.Recurrence{
+			Freq:     gt.RecurrenceMonthly,
+			Interval: 1,
+			ByDay:    []gt.RecurrenceDay{{Nth: 1, Weekday: time.Friday}, {Nth: -1, Weekday: time.Sunday}},
+			BySetPos: []int{-1},
+			Wkst:     time.Monday,
+		},
+		`FREQ=MONTHLY;BYDAY=1FR,-1SU;BYSETPOS=-1`,
+	)
+
+	t.Run(`invalid`, func(t *testing.T) {
+		var val gt.Recurrence
+
+		fail(val.Parse(`COUNT=10`))
+		fail(val.Parse(`FREQ=FORTNIGHTLY`))
+		fail(val.Parse(`FREQ=DAILY;FREQ=DAILY`))
+		fail(val.Parse(`FREQ=DAILY;COUNT=0`))
+		fail(val.Parse(`FREQ=DAILY;COUNT=1;UNTIL=20000101`))
+		fail(val.Parse(`FREQ=DAILY;BYHOUR=24`))
+		fail(val.Parse(`FREQ=DAILY;BYDAY=1MO`))
+		fail(val.Parse(`FREQ=MONTHLY;BYWEEKNO=1`))
+		fail(val.Parse(`FREQ=WEEKLY;BYMONTHDAY=1`))
+		fail(val.Parse(`FREQ=DAILY;BYSETPOS=1`))
+		fail(val.Parse(`FREQ=DAILY;X-NAME=VALUE`))
+		fail(val.Parse(`FREQ=SECONDLY;BYSECOND=60;COUNT=1`))
+		fail(val.Parse(`FREQ=DAILY;BYSECOND=60,60`))
+		eq(`FREQ=SECONDLY;BYSECOND=59,60`, gt.ParseRecurrence(`FREQ=SECONDLY;BYSECOND=59,60`).String())
+		eq(gt.Recurrence{}, val)
+	})
+}
+
+func TestRecurrence_Between(t *testing.T) {
+	loc, err := time.LoadLocation(`America/New_York`)
+	try(err)
+
+	at := func(year int, month time.Month, day, hour int) gt.NullTime {
+		return gt.NullTimeIn(year, month, day, hour, 0, 0, 0, loc)
+	}
+
+	test := func(src string, start, min, max gt.NullTime, exp ...gt.NullTime) {
+		t.Helper()
+		eq(exp, gt.ParseRecurrence(src).Between(start, min, max))
+	}
+
+	test(
+		`FREQ=DAILY;COUNT=3`,
+		at(1997, 9, 2, 9), gt.NullTime{}, gt.NullTime{},
+		at(1997, 9, 2, 9), at(1997, 9, 3, 9), at(1997, 9, 4, 9),
+	)
+
+	// Local time of day is preserved across the DST transition.
+	test(
+		`FREQ=WEEKLY;UNTIL=19971224T000000Z;INTERVAL=2;BYDAY=MO,WE,FR;WKST=SU`,
+		at(1997, 9, 1, 9), at(1997, 10, 20, 0), at(1997, 11, 11, 0),
+		at(1997, 10, 27, 9), at(1997, 10, 29, 9), at(1997, 10, 31, 9), at(1997, 11, 10, 9),
+	)
+
+	// Wall-clock time skipped by DST uses the offset from before the transition.
+	test(
+		`FREQ=DAILY;COUNT=3`,
+		gt.NullTimeIn(2024, 3, 9, 2, 30, 0, 0, loc), gt.NullTime{}, gt.NullTime{},
+		gt.NullTimeIn(2024, 3, 9, 2, 30, 0, 0, loc),
+		gt.NullTimeIn(2024, 3, 10, 3, 30, 0, 0, loc),
+		gt.NullTimeIn(2024, 3, 11, 2, 30, 0, 0, loc),
+	)
+
+	test(
+		`FREQ=MONTHLY;COUNT=4;BYDAY=1FR`,
+		at(1997, 9, 5, 9), gt.NullTime{}, gt.NullTime{},
+		at(1997, 9, 5, 9), at(1997, 10, 3, 9), at(1997, 11, 7, 9), at(1997, 12, 5, 9),
+	)
+
+	test(
+		`FREQ=MONTHLY;BYDAY=MO,TU,WE,TH,FR;BYSETPOS=-1`,
+		at(1997, 9, 29, 9), gt.NullTime{}, at(1998, 1, 1, 0),
+		at(1997, 9, 30, 9), at(1997, 10, 31, 9), at(1997, 11, 28, 9), at(1997, 12, 31, 9),
+	)
+
+	test(
+		`FREQ=YEARLY;COUNT=3;BYWEEKNO=20;BYDAY=MO`,
+		at(1997, 5, 12, 9), gt.NullTime{}, gt.NullTime{},
+		at(1997, 5, 12, 9), at(1998, 5, 11, 9), at(1999, 5, 17, 9),
+	)
+
+	test(
+		`FREQ=YEARLY;COUNT=3;BYDAY=20MO`,
+		at(1997, 5, 19, 9), gt.NullTime{}, gt.NullTime{},
+		at(1997, 5, 19, 9), at(1998, 5, 18, 9), at(1999, 5, 17, 9),
+	)
+
+	test(
+		`FREQ=YEARLY;COUNT=3`,
+		at(2020, 2, 29, 9), gt.NullTime{}, gt.NullTime{},
+		at(2020, 2, 29, 9), at(2024, 2, 29, 9), at(2028, 2, 29, 9),
+	)
+
+	test(
+		`FREQ=HOURLY;INTERVAL=3;UNTIL=19970902T170000`,
+		at(1997, 9, 2, 9), gt.NullTime{}, gt.NullTime{},
+		at(1997, 9, 2, 9), at(1997, 9, 2, 12), at(1997, 9, 2, 15),
+	)
+
+	test(
+		`FREQ=HOURLY;BYHOUR=9;COUNT=2`,
+		at(1997, 9, 2, 10), gt.NullTime{}, gt.NullTime{},
+		at(1997, 9, 3, 9), at(1997, 9, 4, 9),
+	)
+
+	// Terminates at the max year, without overflowing period arithmetic.
+	t.Run(`never_matches`, func(t *testing.T) {
+		test := func(src string) {
+			t.Helper()
+			eq(
+				[]gt.NullTime(nil),
+				gt.ParseRecurrence(src).Between(at(2024, 1, 1, 0), gt.NullTime{}, at(2025, 1, 1, 0)),
+			)
+		}
+
+		test(`FREQ=HOURLY;BYMONTH=2;BYMONTHDAY=30`)
+		test(`FREQ=MINUTELY;BYMONTH=2;BYMONTHDAY=30`)
+		test(`FREQ=HOURLY;INTERVAL=9223372036854775807;BYHOUR=3`)
+		test(`FREQ=YEARLY;INTERVAL=9223372036854775807;BYMONTH=2;BYMONTHDAY=30`)
+	})
+
+	// Leap seconds never occur. Such rules are rejected by `.Validate`, but may
+	// be constructed directly, and must terminate without stepping through every
+	// second until the max year.
+	t.Run(`leap_second`, func(t *testing.T) {
+		rule := gt.Recurrence{Freq: gt.RecurrenceSecondly, Count: 1, BySecond: []int{60}, Wkst: time.Monday}
+		fail(rule.Validate())
+
+		done := make(chan []gt.NullTime, 1)
+		go func() {
+			done <- rule.Between(at(2024, 1, 1, 0), gt.NullTime{}, gt.NullTime{})
+		}()
+
+		select {
+		case val := <-done:
+			eq([]gt.NullTime(nil), val)
+		case <-time.After(5 * time.Second):
+			t.Fatal(`recurrence with only leap seconds didn't terminate`)
+		}
+	})
+
+	t.Run(`exclude`, func(t *testing.T) {
+		eq(
+			[]gt.NullTime{at(1998, 3, 13, 9), at(1998, 11, 13, 9)},
+			gt.ParseRecurrence(`FREQ=MONTHLY;BYDAY=FR;BYMONTHDAY=13;COUNT=3`).Between(
+				at(1997, 9, 2, 9), gt.NullTime{}, gt.NullTime{}, at(1998, 2, 13, 9),
+			),
+		)
+	})
+}
+
+func TestRecurrence_DatesBetween(t *testing.T) {
+	eq(
+		[]gt.NullDate{
+			gt.NullDateFrom(2024, 1, 31),
+			gt.NullDateFrom(2024, 3, 31),
+			gt.NullDateFrom(2024, 5, 31),
+		},
+		gt.ParseRecurrence(`FREQ=MONTHLY;BYMONTHDAY=-1;UNTIL=20240531`).DatesBetween(
+			gt.NullDateFrom(2024, 1, 1),
+			gt.NullDate{},
+			gt.NullDate{},
+			gt.NullDateFrom(2024, 2, 29),
+			gt.NullDateFrom(2024, 4, 30),
+		),
+	)
+}
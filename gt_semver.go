@@ -0,0 +1,444 @@
+package gt
+
+import (
+	"database/sql/driver"
+	"strconv"
+	"strings"
+)
+
+/*
+Shortcut: parses successfully or panics. Should be used only in root scope. When
+error handling is relevant, use `.Parse`.
+*/
+func ParseSemver(src string) (val Semver) {
+	try(val.Parse(src))
+	return
+}
+
+// Simplified constructor for a version without pre-release or build metadata.
+func SemverFrom(major, minor, patch uint64) Semver {
+	return Semver{Major: major, Minor: minor, Patch: patch}
+}
+
+/*
+Semantic version as defined by SemVer 2.0.0 (https://semver.org), such as
+"1.2.3", "1.0.0-alpha.1" or "1.0.0+20130313144700". Zero value represents
+"0.0.0".
+
+Features:
+
+  - Reversible encoding/decoding in text.
+  - Reversible encoding/decoding in JSON.
+  - Reversible encoding/decoding in SQL.
+  - Precedence ordering via `.Compare` and `.Less`.
+
+Text encoding and decoding uses the standard format:
+
+	MAJOR.MINOR.PATCH[-PRERELEASE][+BUILD]
+
+Decoding is strict: all three numeric components are required, numeric
+identifiers must not have leading zeros, and the "v" prefix is not allowed.
+The pre-release and build metadata are stored without the leading "-" and "+".
+
+Because build metadata doesn't affect precedence, `==` is not equivalent to
+`.Equal`. For version ranges, see `gt.SemverConstraint`. For a nullable
+variant, see `gt.NullSemver`.
+*/
+type Semver struct {
+	Major uint64
+	Minor uint64
+	Patch uint64
+	Pre   string
+	Build string
+}
+
+var (
+	_ = Encodable(Semver{})
+	_ = Decodable((*Semver)(nil))
+)
+
+// Implement `gt.Zeroable`. Equivalent to `reflect.ValueOf(self).IsZero()`.
+func (self Semver) IsZero() bool { return self == Semver{} }
+
+// Implement `gt.Nullable`. Always `false`.
+func (self Semver) IsNull() bool { return false }
+
+// Implement `gt.Getter`, using `.String` to return a string representation.
+func (self Semver) Get() any { return self.String() }
+
+// Implement `gt.Setter`, using `.Scan`. Panics on error.
+func (self *Semver) Set(src any) { try(self.Scan(src)) }
+
+// Implement `gt.Zeroer`, zeroing the receiver.
+func (self *Semver) Zero() {
+	if self != nil {
+		*self = Semver{}
+	}
+}
+
+// Implement `fmt.Stringer`, returning a text representation in the standard format.
+func (self Semver) String() string {
+	return bytesString(self.AppendTo(nil))
+}
+
+// Implement `gt.Parser`, parsing a valid SemVer 2.0.0 representation.
+func (self *Semver) Parse(src string) (err error) {
+	defer errParse(&err, src, `semantic version`)
+
+	val, parts, err := semverParse(src, false)
+	if err != nil {
+		return err
+	}
+	if parts < 3 {
+		return errFormatMismatch
+	}
+
+	*self = val
+	return nil
+}
+
+// Implement `gt.AppenderTo`, using the same representation as `.String`.
+func (self Semver) AppendTo(buf []byte) []byte {
+	return self.appendParts(buf, 3)
+}
+
+// Implement `encoding.TextMarhaler`, using the same representation as `.String`.
+func (self Semver) MarshalText() ([]byte, error) {
+	return self.AppendTo(nil), nil
+}
+
+// Implement `encoding.TextUnmarshaler`, using the same algorithm as `.Parse`.
+func (self *Semver) UnmarshalText(src []byte) error {
+	// Not using `bytesString` because the pre-release and build strings may
+	// reference the source, and streaming decoders tend to reuse one buffer for
+	// different content.
+	return self.Parse(string(src))
+}
+
+/*
+Implement `json.Marshaler`, returning bytes representing a JSON string with the
+same text as in `.String`.
+*/
+func (self Semver) MarshalJSON() ([]byte, error) {
+	buf := make([]byte, 0, self.bufLen()+2)
+	buf = append(buf, '"')
+	buf = self.AppendTo(buf)
+	buf = append(buf, '"')
+	return buf, nil
+}
+
+// Implement `json.Unmarshaler`, using the same algorithm as `.Parse`.
+func (self *Semver) UnmarshalJSON(src []byte) error {
+	if isJsonStr(src) {
+		return self.UnmarshalText(cutJsonStr(src))
+	}
+	return errJsonString(src, self)
+}
+
+// Implement `driver.Valuer`, using `.Get`.
+func (self Semver) Value() (driver.Value, error) {
+	return self.Get(), nil
+}
+
+/*
+Implement `sql.Scanner`, converting an arbitrary input to `gt.Semver` and
+modifying the receiver. Acceptable inputs:
+
+  - `string`        -> use `.Parse`
+  - `[]byte`        -> use `.UnmarshalText`
+  - `gt.Semver`     -> assign
+  - `gt.NullSemver` -> assign
+  - `gt.Getter`     -> scan underlying value
+*/
+func (self *Semver) Scan(src any) error {
+	switch src := src.(type) {
+	case string:
+		return self.Parse(src)
+
+	case []byte:
+		return self.UnmarshalText(src)
+
+	case Semver:
+		*self = src
+		return nil
+
+	case NullSemver:
+		*self = Semver(src)
+		return nil
+
+	default:
+		val, ok := get(src)
+		if ok {
+			return self.Scan(val)
+		}
+		return errScanType(self, src)
+	}
+}
+
+/*
+Compares two versions by SemVer precedence, returning -1 if the receiver is
+lower, 1 if the receiver is higher, and 0 if they have equal precedence.
+Numeric components are compared numerically. A pre-release version is lower
+than the same version without a pre-release. Pre-release identifiers are
+compared one by one: numeric identifiers numerically, others in ASCII order,
+numeric identifiers are lower than others, and a shorter list of identifiers
+is lower if all preceding identifiers are equal. Build metadata is ignored.
+*/
+func (self Semver) Compare(val Semver) int {
+	if out := compareUint64(self.Major, val.Major); out != 0 {
+		return out
+	}
+	if out := compareUint64(self.Minor, val.Minor); out != 0 {
+		return out
+	}
+	if out := compareUint64(self.Patch, val.Patch); out != 0 {
+		return out
+	}
+	return semverComparePre(self.Pre, val.Pre)
+}
+
+// True if the receiver has lower precedence. See `gt.Semver.Compare`.
+func (self Semver) Less(val Semver) bool { return self.Compare(val) < 0 }
+
+// Equivalent to `self.Equal(val) || self.Less(val)`.
+func (self Semver) LessOrEqual(val Semver) bool { return self.Compare(val) <= 0 }
+
+/*
+True if the versions have equal precedence. Unlike `==`, ignores build
+metadata. See `gt.Semver.Compare`.
+*/
+func (self Semver) Equal(val Semver) bool { return self.Compare(val) == 0 }
+
+// True if the version has a pre-release, such as "1.0.0-alpha".
+func (self Semver) IsPre() bool { return self.Pre != `` }
+
+// Returns only the numeric components, without pre-release and build metadata.
+func (self Semver) Core() Semver {
+	return SemverFrom(self.Major, self.Minor, self.Patch)
+}
+
+// Returns the next major version, such as "2.0.0" for "1.2.3".
+func (self Semver) NextMajor() Semver { return SemverFrom(self.Major+1, 0, 0) }
+
+// Returns the next minor version, such as "1.3.0" for "1.2.3".
+func (self Semver) NextMinor() Semver { return SemverFrom(self.Major, self.Minor+1, 0) }
+
+/*
+Returns the next patch version, such as "1.2.4" for "1.2.3". For pre-release
+versions, returns the same version without the pre-release, such as "1.2.3"
+for "1.2.3-alpha", because that's the next version by precedence.
+*/
+func (self Semver) NextPatch() Semver {
+	if self.IsPre() {
+		return self.Core()
+	}
+	return SemverFrom(self.Major, self.Minor, self.Patch+1)
+}
+
+// Appends the first N numeric components; pre-release and build require all 3.
+func (self Semver) appendParts(buf []byte, parts int) []byte {
+	buf = strconv.AppendUint(buf, self.Major, 10)
+	if parts < 2 {
+		return buf
+	}
+	buf = append(buf, '.')
+	buf = strconv.AppendUint(buf, self.Minor, 10)
+	if parts < 3 {
+		return buf
+	}
+	buf = append(buf, '.')
+	buf = strconv.AppendUint(buf, self.Patch, 10)
+
+	if self.Pre != `` {
+		buf = append(buf, '-')
+		buf = append(buf, self.Pre...)
+	}
+	if self.Build != `` {
+		buf = append(buf, '+')
+		buf = append(buf, self.Build...)
+	}
+	return buf
+}
+
+func (self Semver) bufLen() int {
+	// 3 numbers of up to 20 digits, separators, pre-release and build.
+	return 3*20 + 4 + len(self.Pre) + len(self.Build)
+}
+
+/*
+Parses a version, returning the number of numeric components, which is always
+3 unless `partial` is true. Partial versions such as "1", "1.2" or "1.x" omit
+trailing components or replace them with wildcards "x", "X" or "*", and may not
+have pre-release or build metadata. The lone wildcard "*" has 0 components.
+*/
+func semverParse(src string, partial bool) (out Semver, parts int, err error) {
+	core := src
+
+	if ind := strings.IndexByte(core, '+'); ind >= 0 {
+		out.Build = core[ind+1:]
+		core = core[:ind]
+		err = semverValidIdents(out.Build, false)
+		if err != nil {
+			return
+		}
+	}
+
+	if ind := strings.IndexByte(core, '-'); ind >= 0 {
+		out.Pre = core[ind+1:]
+		core = core[:ind]
+		err = semverValidIdents(out.Pre, true)
+		if err != nil {
+			return
+		}
+	}
+
+	nums := [3]*uint64{&out.Major, &out.Minor, &out.Patch}
+	wild := false
+
+	for ind, chunk := range strings.Split(core, `.`) {
+		if ind >= len(nums) {
+			return out, 0, errFormatMismatch
+		}
+
+		if partial && (chunk == `x` || chunk == `X` || chunk == `*`) {
+			wild = true
+			continue
+		}
+		if wild {
+			return out, 0, errFormatMismatch
+		}
+
+		err = semverValidNum(chunk)
+		if err != nil {
+			return
+		}
+
+		*nums[ind], err = strconv.ParseUint(chunk, 10, 64)
+		if err != nil {
+			return
+		}
+		parts++
+	}
+
+	if parts < 3 && (!partial || out.Pre != `` || out.Build != ``) {
+		return out, 0, errFormatMismatch
+	}
+	return out, parts, nil
+}
+
+func semverValidNum(src string) error {
+	if len(src) <= 0 {
+		return errSemverEmptyIdent
+	}
+	for ind := 0; ind < len(src); ind++ {
+		if !charsetDigitDec.has(src[ind]) {
+			return errInvalidCharAt(src, ind)
+		}
+	}
+	if len(src) > 1 && src[0] == '0' {
+		return errSemverLeadingZero
+	}
+	return nil
+}
+
+// Validates dot-separated pre-release or build identifiers.
+func semverValidIdents(src string, pre bool) error {
+	for _, ident := range strings.Split(src, `.`) {
+		if len(ident) <= 0 {
+			return errSemverEmptyIdent
+		}
+		for ind := 0; ind < len(ident); ind++ {
+			if !charsetSemverIdent.has(ident[ind]) {
+				return errInvalidCharAt(ident, ind)
+			}
+		}
+		if pre && len(ident) > 1 && ident[0] == '0' && isSemverNum(ident) {
+			return errSemverLeadingZero
+		}
+	}
+	return nil
+}
+
+var charsetSemverIdent = new(charset).add(`0123456789abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ-`)
+
+func isSemverNum(src string) bool {
+	for ind := 0; ind < len(src); ind++ {
+		if !charsetDigitDec.has(src[ind]) {
+			return false
+		}
+	}
+	return len(src) > 0
+}
+
+func semverComparePre(one, two string) int {
+	if one == two {
+		return 0
+	}
+	if one == `` {
+		return 1
+	}
+	if two == `` {
+		return -1
+	}
+
+	for {
+		var headOne, headTwo string
+		headOne, one = cutDot(one)
+		headTwo, two = cutDot(two)
+
+		if out := semverCompareIdent(headOne, headTwo); out != 0 {
+			return out
+		}
+		if one == `` || two == `` {
+			return compareInt(len(one), len(two))
+		}
+	}
+}
+
+func semverCompareIdent(one, two string) int {
+	numOne, numTwo := isSemverNum(one), isSemverNum(two)
+
+	if numOne && numTwo {
+		// Numeric identifiers don't have leading zeros, so longer is larger. This
+		// also avoids overflow.
+		if out := compareInt(len(one), len(two)); out != 0 {
+			return out
+		}
+		return strings.Compare(one, two)
+	}
+	if numOne {
+		return -1
+	}
+	if numTwo {
+		return 1
+	}
+	return strings.Compare(one, two)
+}
+
+func cutDot(src string) (string, string) {
+	ind := strings.IndexByte(src, '.')
+	if ind < 0 {
+		return src, ``
+	}
+	return src[:ind], src[ind+1:]
+}
+
+func compareUint64(one, two uint64) int {
+	if one < two {
+		return -1
+	}
+	if one > two {
+		return 1
+	}
+	return 0
+}
+
+func compareInt(one, two int) int {
+	if one < two {
+		return -1
+	}
+	if one > two {
+		return 1
+	}
+	return 0
+}
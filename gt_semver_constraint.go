@@ -0,0 +1,425 @@
+package gt
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"strings"
+)
+
+/*
+Shortcut: parses successfully or panics. Should be used only in root scope. When
+error handling is relevant, use `.Parse`.
+*/
+func ParseSemverConstraint(src string) (val SemverConstraint) {
+	try(val.Parse(src))
+	return
+}
+
+/*
+Semantic version constraint, such as ">=1.2.0 <2.0.0", "^1.4" or "~1.4.2",
+where zero value is considered empty in text, and null in JSON and SQL. Zero
+value has no constraints and matches any version. Use `.Match` to check a
+version.
+
+The syntax is a subset of the one used by npm. A constraint is a list of
+alternatives separated by "||", and matches a version if any alternative
+matches. Each alternative is a list of comparators separated by spaces or
+commas, and matches a version if every comparator matches. Comparators:
+
+	=1.2.3  1.2.3  exactly this version, ignoring build metadata
+	>1.2.3         higher than this version
+	>=1.2.3        this version or higher
+	<1.2.3         lower than this version
+	<=1.2.3        this version or lower
+	1.2  1.2.x     >=1.2.0 <1.3.0
+	1  1.x         >=1.0.0 <2.0.0
+	*              any version
+	^1.2.3  ^1.4   >=1.2.3 <2.0.0, >=1.4.0 <2.0.0
+	^0.2.3  ^0.0.3 >=0.2.3 <0.3.0, >=0.0.3 <0.0.4
+	~1.4.2  ~1.4   >=1.4.2 <1.5.0, >=1.4.0 <1.5.0
+	~1             >=1.0.0 <2.0.0
+
+The operators ">", ">=", "<", "<=" require a full version. Versions are
+compared by precedence via `gt.Semver.Compare`. Unlike npm, pre-release
+versions are not treated specially: ">=1.0.0-rc.1" matches "1.0.0" and
+"1.2.0-beta" alike. Upper bounds implied by "^", "~" and partial versions
+exclude pre-releases of the bound, so "^1.4" doesn't match "2.0.0-alpha". An
+explicit "<2.0.0" does match "2.0.0-alpha"; to exclude it, use "<2.0.0-0".
+
+Text encoding uses a normalized form of the source, where comparators are
+separated by single spaces and alternatives by " || ". Decoding is
+reversible.
+*/
+type SemverConstraint struct {
+	alts [][]semverComparator
+}
+
+var (
+	_ = Encodable(SemverConstraint{})
+	_ = Decodable((*SemverConstraint)(nil))
+)
+
+// Implement `gt.Zeroable`. True if there are no constraints.
+func (self SemverConstraint) IsZero() bool { return len(self.alts) <= 0 }
+
+// Implement `gt.Nullable`. True if zero.
+func (self SemverConstraint) IsNull() bool { return self.IsZero() }
+
+/*
+Implement `gt.Getter`. If zero, returns `nil`, otherwise uses `.String` to
+return a string representation.
+*/
+func (self SemverConstraint) Get() any {
+	if self.IsNull() {
+		return nil
+	}
+	return self.String()
+}
+
+// Implement `gt.Setter`, using `.Scan`. Panics on error.
+func (self *SemverConstraint) Set(src any) { try(self.Scan(src)) }
+
+// Implement `gt.Zeroer`, zeroing the receiver.
+func (self *SemverConstraint) Zero() {
+	if self != nil {
+		*self = SemverConstraint{}
+	}
+}
+
+/*
+Implement `fmt.Stringer`. If zero, returns an empty string. Otherwise returns
+the normalized representation described in the type's description.
+*/
+func (self SemverConstraint) String() string {
+	if self.IsNull() {
+		return ``
+	}
+	return bytesString(self.AppendTo(nil))
+}
+
+/*
+Implement `gt.Parser`. If the input is empty or consists only of whitespace,
+zeroes the receiver. Otherwise parses the syntax described in the type's
+description.
+*/
+func (self *SemverConstraint) Parse(src string) (err error) {
+	defer errParse(&err, src, `semver constraint`)
+
+	var alts [][]semverComparator
+
+	if strings.TrimSpace(src) != `` {
+		for _, chunk := range strings.Split(src, `||`) {
+			alt, err := semverParseAlt(chunk)
+			if err != nil {
+				return err
+			}
+			alts = append(alts, alt)
+		}
+	}
+
+	*self = SemverConstraint{alts}
+	return nil
+}
+
+// Implement `gt.AppenderTo`, using the same representation as `.String`.
+func (self SemverConstraint) AppendTo(buf []byte) []byte {
+	for ind, alt := range self.alts {
+		if ind > 0 {
+			buf = append(buf, ` || `...)
+		}
+		for ind, val := range alt {
+			if ind > 0 {
+				buf = append(buf, ' ')
+			}
+			buf = val.appendTo(buf)
+		}
+	}
+	return buf
+}
+
+/*
+Implement `encoding.TextMarhaler`. If zero, returns nil. Otherwise returns the
+same representation as `.String`.
+*/
+func (self SemverConstraint) MarshalText() ([]byte, error) {
+	if self.IsNull() {
+		return nil, nil
+	}
+	return self.AppendTo(nil), nil
+}
+
+// Implement `encoding.TextUnmarshaler`, using the same algorithm as `.Parse`.
+func (self *SemverConstraint) UnmarshalText(src []byte) error {
+	// Not using `bytesString` because pre-release and build strings may
+	// reference the source.
+	return self.Parse(string(src))
+}
+
+/*
+Implement `json.Marshaler`. If zero, returns bytes representing `null`.
+Otherwise returns bytes representing a JSON string with the same text as in
+`.String`.
+*/
+func (self SemverConstraint) MarshalJSON() ([]byte, error) {
+	if self.IsNull() {
+		return bytesNull, nil
+	}
+
+	buf := make([]byte, 0, 64)
+	buf = append(buf, '"')
+	buf = self.AppendTo(buf)
+	buf = append(buf, '"')
+	return buf, nil
+}
+
+/*
+Implement `json.Unmarshaler`. If the input is empty or represents JSON `null`,
+zeroes the receiver. Otherwise decodes a JSON string and parses it, using the
+same algorithm as `.Parse`. Unlike most other types, this decodes escape
+sequences, because `json.Marshal` escapes "<" and ">" as "\u003c" and "\u003e".
+*/
+func (self *SemverConstraint) UnmarshalJSON(src []byte) error {
+	if isJsonEmpty(src) {
+		self.Zero()
+		return nil
+	}
+	if !isJsonStr(src) {
+		return errJsonString(src, self)
+	}
+
+	var val string
+	err := json.Unmarshal(src, &val)
+	if err != nil {
+		return err
+	}
+	return self.Parse(val)
+}
+
+// Implement `driver.Valuer`, using `.Get`.
+func (self SemverConstraint) Value() (driver.Value, error) {
+	return self.Get(), nil
+}
+
+/*
+Implement `sql.Scanner`, converting an arbitrary input to `gt.SemverConstraint`
+and modifying the receiver. Acceptable inputs:
+
+  - `nil`                 -> use `.Zero`
+  - `string`              -> use `.Parse`
+  - `[]byte`              -> use `.UnmarshalText`
+  - `gt.SemverConstraint` -> assign
+  - `gt.Getter`           -> scan underlying value
+*/
+func (self *SemverConstraint) Scan(src any) error {
+	switch src := src.(type) {
+	case nil:
+		self.Zero()
+		return nil
+
+	case string:
+		return self.Parse(src)
+
+	case []byte:
+		return self.UnmarshalText(src)
+
+	case SemverConstraint:
+		*self = src
+		return nil
+
+	default:
+		val, ok := get(src)
+		if ok {
+			return self.Scan(val)
+		}
+		return errScanType(self, src)
+	}
+}
+
+/*
+True if the version satisfies the constraint: if any alternative has all of its
+comparators satisfied. If the constraint is zero, returns true.
+*/
+func (self SemverConstraint) Match(val Semver) bool {
+	if self.IsZero() {
+		return true
+	}
+
+outer:
+	for _, alt := range self.alts {
+		for _, comp := range alt {
+			if !comp.match(val) {
+				continue outer
+			}
+		}
+		return true
+	}
+	return false
+}
+
+/*
+Same as `.Match` for non-null versions. If the version is null, returns true
+only if the constraint is also zero.
+*/
+func (self SemverConstraint) MatchNull(val NullSemver) bool {
+	if val.IsNull() {
+		return self.IsZero()
+	}
+	return self.Match(Semver(val))
+}
+
+type semverComparator struct {
+	op    string
+	ver   Semver
+	parts int
+}
+
+var semverOps = []string{`>=`, `<=`, `>`, `<`, `=`, `^`, `~`}
+
+func semverParseAlt(src string) (out []semverComparator, err error) {
+	fields := strings.FieldsFunc(src, isSemverSep)
+	if len(fields) <= 0 {
+		return nil, errFormatMismatch
+	}
+
+	for ind := 0; ind < len(fields); ind++ {
+		field := fields[ind]
+		op := semverCutOp(field)
+
+		// Allow a space between operator and version, such as ">= 1.2.3".
+		if op == field && ind+1 < len(fields) {
+			ind++
+			field += fields[ind]
+		}
+
+		val, err := semverParseComparator(op, field[len(op):])
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, val)
+	}
+	return out, nil
+}
+
+func semverParseComparator(op string, src string) (out semverComparator, err error) {
+	if len(src) <= 0 {
+		return out, errFormatMismatch
+	}
+	if semverCutOp(src) != `` {
+		return out, errSemverOp
+	}
+
+	out.op = op
+	out.ver, out.parts, err = semverParse(src, true)
+	if err != nil {
+		return
+	}
+
+	switch op {
+	case `>`, `>=`, `<`, `<=`:
+		if out.parts < 3 {
+			return out, errSemverPartial
+		}
+	case `^`, `~`:
+		if out.parts < 1 {
+			return out, errFormatMismatch
+		}
+	}
+	return out, nil
+}
+
+func semverCutOp(src string) string {
+	for _, op := range semverOps {
+		if strings.HasPrefix(src, op) {
+			return op
+		}
+	}
+	return ``
+}
+
+func isSemverSep(char rune) bool {
+	return char == ' ' || char == '\t' || char == '\n' || char == '\r' || char == ','
+}
+
+func (self semverComparator) appendTo(buf []byte) []byte {
+	buf = append(buf, self.op...)
+	if self.parts <= 0 {
+		return append(buf, '*')
+	}
+	return self.ver.appendParts(buf, self.parts)
+}
+
+func (self semverComparator) match(val Semver) bool {
+	switch self.op {
+	case `>`:
+		return val.Compare(self.ver) > 0
+	case `>=`:
+		return val.Compare(self.ver) >= 0
+	case `<`:
+		return val.Compare(self.ver) < 0
+	case `<=`:
+		return val.Compare(self.ver) <= 0
+	}
+
+	if self.parts <= 0 {
+		return true
+	}
+	if (self.op == `` || self.op == `=`) && self.parts >= 3 {
+		return val.Compare(self.ver) == 0
+	}
+
+	if val.Compare(self.ver) < 0 {
+		return false
+	}
+	upper, ok := self.upper()
+	return !ok || val.Compare(upper) < 0
+}
+
+/*
+Returns the exclusive upper bound for ranges, using the lowest possible
+pre-release "0" so that pre-releases of the bound are excluded. Returns false
+if there's no upper bound.
+*/
+func (self semverComparator) upper() (out Semver, ok bool) {
+	ver := self.ver
+	level := self.parts
+
+	switch self.op {
+	case `^`:
+		if ver.Major > 0 || self.parts == 1 {
+			level = 1
+		} else if ver.Minor > 0 || self.parts == 2 {
+			level = 2
+		} else {
+			level = 3
+		}
+	case `~`:
+		if self.parts > 1 {
+			level = 2
+		}
+	}
+
+	const maxUint64 = ^uint64(0)
+
+	switch level {
+	case 1:
+		if ver.Major == maxUint64 {
+			return out, false
+		}
+		out = ver.NextMajor()
+	case 2:
+		if ver.Minor == maxUint64 {
+			return out, false
+		}
+		out = ver.NextMinor()
+	case 3:
+		if ver.Patch == maxUint64 {
+			return out, false
+		}
+		out = SemverFrom(ver.Major, ver.Minor, ver.Patch+1)
+	default:
+		return out, false
+	}
+
+	out.Pre = `0`
+	return out, true
+}
@@ -0,0 +1,339 @@
+package gt_test
+
+import (
+	"math"
+	"sort"
+	"testing"
+
+	"github.com/mitranim/gt"
+)
+
+func TestSemver_common(t *testing.T) {
+	var (
+		primZero    = `0.0.0`
+		primNonZero = `1.2.3-rc.1+build.5`
+		textZero    = `0.0.0`
+		textNonZero = `1.2.3-rc.1+build.5`
+		jsonZero    = []byte(`"0.0.0"`)
+		jsonNonZero = []byte(`"1.2.3-rc.1+build.5"`)
+		zero        = gt.Semver{}
+		nonZero     = gt.Semver{Major: 1, Minor: 2, Patch: 3, Pre: `rc.1`, Build: `build.5`}
+		dec         = new(gt.Semver)
+	)
+
+	testAny(t, primZero, primNonZero, textZero, textNonZero, jsonZero, jsonNonZero, zero, nonZero, dec)
+}
+
+func TestNullSemver_common(t *testing.T) {
+	var (
+		primZero    = any(nil)
+		primNonZero = `1.2.3-rc.1+build.5`
+		textZero    = ``
+		textNonZero = `1.2.3-rc.1+build.5`
+		jsonZero    = bytesNull
+		jsonNonZero = []byte(`"1.2.3-rc.1+build.5"`)
+		zero        = gt.NullSemver{}
+		nonZero     = gt.NullSemver{Major: 1, Minor: 2, Patch: 3, Pre: `rc.1`, Build: `build.5`}
+		dec         = new(gt.NullSemver)
+	)
+
+	eq(true, zero.IsNull())
+	eq(false, nonZero.IsNull())
+
+	testAny(t, primZero, primNonZero, textZero, textNonZero, jsonZero, jsonNonZero, zero, nonZero, dec)
+}
+
+func TestSemverConstraint_common(t *testing.T) {
+	var (
+		primZero    = any(nil)
+		primNonZero = `>=1.2.0 <2.0.0 || ^3.1`
+		textZero    = ``
+		textNonZero = `>=1.2.0 <2.0.0 || ^3.1`
+		jsonZero    = bytesNull
+		jsonNonZero = []byte(`"\u003e=1.2.0 \u003c2.0.0 || ^3.1"`)
+		zero        = gt.SemverConstraint{}
+		nonZero     = gt.ParseSemverConstraint(`>=1.2.0 <2.0.0 || ^3.1`)
+		dec         = new(gt.SemverConstraint)
+	)
+
+	eq(true, zero.IsNull())
+	eq(false, nonZero.IsNull())
+
+	testAny(t, primZero, primNonZero, textZero, textNonZero, jsonZero, jsonNonZero, zero, nonZero, dec)
+}
+
+func TestSemver(t *testing.T) {
+	t.Run(`Parse`, func(t *testing.T) {
+		test := func(exp gt.Semver, src string) {
+			t.Helper()
+			eq(exp, gt.ParseSemver(src))
+			eq(src, exp.String())
+		}
+
+		test(gt.Semver{}, `0.0.0`)
+		test(gt.SemverFrom(1, 2, 3), `1.2.3`)
+		test(gt.SemverFrom(10, 20, 30), `10.20.30`)
+		test(gt.Semver{Major: 1, Pre: `alpha`}, `1.0.0-alpha`)
+		test(gt.Semver{Major: 1, Pre: `alpha.1`}, `1.0.0-alpha.1`)
+		test(gt.Semver{Major: 1, Pre: `0.3.7`}, `1.0.0-0.3.7`)
+		test(gt.Semver{Major: 1, Pre: `x.7.z.92`}, `1.0.0-x.7.z.92`)
+		test(gt.Semver{Major: 1, Pre: `x-y-z.--`}, `1.0.0-x-y-z.--`)
+		test(gt.Semver{Major: 1, Build: `20130313144700`}, `1.0.0+20130313144700`)
+		test(gt.Semver{Major: 1, Pre: `beta`, Build: `exp.sha.5114f85`}, `1.0.0-beta+exp.sha.5114f85`)
+		test(gt.Semver{Major: 1, Build: `21AF26D3----117B344092BD`}, `1.0.0+21AF26D3----117B344092BD`)
+		test(gt.Semver{Major: 1, Build: `001`}, `1.0.0+001`)
+		test(gt.SemverFrom(math.MaxUint64, 0, 0), `18446744073709551615.0.0`)
+
+		var val gt.Semver
+		fail(val.Parse(``))
+		fail(val.Parse(`1`))
+		fail(val.Parse(`1.2`))
+		fail(val.Parse(`1.2.3.4`))
+		fail(val.Parse(`v1.2.3`))
+		fail(val.Parse(`01.2.3`))
+		fail(val.Parse(`1.02.3`))
+		fail(val.Parse(`1.2.03`))
+		fail(val.Parse(`1.2.3-01`))
+		fail(val.Parse(`1.2.3-`))
+		fail(val.Parse(`1.2.3+`))
+		fail(val.Parse(`1.2.3-alpha..1`))
+		fail(val.Parse(`1.2.3+build..1`))
+		fail(val.Parse(`1.2.3-alpha_1`))
+		fail(val.Parse(`1.2.3+build+1`))
+		fail(val.Parse(`1.2.x`))
+		fail(val.Parse(`-1.2.3`))
+		fail(val.Parse(` 1.2.3`))
+		fail(val.Parse(`18446744073709551616.0.0`))
+		eq(gt.Semver{}, val)
+	})
+
+	t.Run(`Compare`, func(t *testing.T) {
+		// Example from the SemVer 2.0.0 spec, in ascending order.
+		ordered := []string{
+			`1.0.0-alpha`,
+			`1.0.0-alpha.1`,
+			`1.0.0-alpha.beta`,
+			`1.0.0-beta`,
+			`1.0.0-beta.2`,
+			`1.0.0-beta.11`,
+			`1.0.0-rc.1`,
+			`1.0.0`,
+			`1.0.1`,
+			`1.1.0`,
+			`1.10.0`,
+			`2.0.0`,
+		}
+
+		for ind, one := range ordered {
+			for ind2, two := range ordered {
+				exp := 0
+				if ind < ind2 {
+					exp = -1
+				} else if ind > ind2 {
+					exp = 1
+				}
+				eq(exp, gt.ParseSemver(one).Compare(gt.ParseSemver(two)))
+				eq(exp < 0, gt.ParseSemver(one).Less(gt.ParseSemver(two)))
+			}
+		}
+
+		shuffled := []gt.Semver{
+			gt.ParseSemver(`1.10.0`),
+			gt.ParseSemver(`1.0.0-beta.11`),
+			gt.ParseSemver(`1.0.0`),
+			gt.ParseSemver(`1.0.0-alpha`),
+			gt.ParseSemver(`2.0.0`),
+			gt.ParseSemver(`1.0.0-rc.1`),
+			gt.ParseSemver(`1.0.0-alpha.beta`),
+			gt.ParseSemver(`1.1.0`),
+			gt.ParseSemver(`1.0.0-beta.2`),
+			gt.ParseSemver(`1.0.0-alpha.1`),
+			gt.ParseSemver(`1.0.1`),
+			gt.ParseSemver(`1.0.0-beta`),
+		}
+		sort.Slice(shuffled, func(one, two int) bool { return shuffled[one].Less(shuffled[two]) })
+
+		var sorted []string
+		for _, val := range shuffled {
+			sorted = append(sorted, val.String())
+		}
+		eq(ordered, sorted)
+
+		eq(true, gt.ParseSemver(`1.0.0+a`).Equal(gt.ParseSemver(`1.0.0+b`)))
+		eq(false, gt.ParseSemver(`1.0.0+a`) == gt.ParseSemver(`1.0.0+b`))
+		eq(-1, gt.ParseSemver(`1.0.0-999999999999999999999`).Compare(gt.ParseSemver(`1.0.0-a`)))
+		eq(1, gt.ParseSemver(`1.0.0-999999999999999999999`).Compare(gt.ParseSemver(`1.0.0-99999999999999999999`)))
+	})
+
+	t.Run(`methods`, func(t *testing.T) {
+		val := gt.ParseSemver(`1.2.3-rc.1+build`)
+		eq(true, val.IsPre())
+		eq(gt.SemverFrom(1, 2, 3), val.Core())
+		eq(gt.SemverFrom(2, 0, 0), val.NextMajor())
+		eq(gt.SemverFrom(1, 3, 0), val.NextMinor())
+		eq(gt.SemverFrom(1, 2, 3), val.NextPatch())
+		eq(gt.SemverFrom(1, 2, 4), val.Core().NextPatch())
+		eq(false, val.Core().IsPre())
+	})
+
+	t.Run(`Scan`, func(t *testing.T) {
+		var val gt.Semver
+		try(val.Scan(`1.2.3`))
+		eq(gt.SemverFrom(1, 2, 3), val)
+
+		try(val.Scan(gt.NullSemverFrom(2, 3, 4)))
+		eq(gt.SemverFrom(2, 3, 4), val)
+
+		fail(val.Scan(nil))
+		fail(val.Scan(int64(1)))
+	})
+}
+
+func TestNullSemver(t *testing.T) {
+	eq(gt.NullSemver{}, gt.ParseNullSemver(``))
+	eq(gt.NullSemverFrom(1, 2, 3), gt.ParseNullSemver(`1.2.3`))
+	eq(``, gt.ParseNullSemver(`0.0.0`).String())
+	eq(gt.SemverFrom(1, 2, 3), gt.NullSemverFrom(1, 2, 3).Semver())
+	eq(true, gt.NullSemver{}.Less(gt.NullSemverFrom(0, 0, 1)))
+	eq(true, gt.ParseNullSemver(`1.0.0-rc.1`).IsPre())
+	fail(new(gt.NullSemver).Parse(`1.2`))
+
+	var val gt.NullSemver
+	try(val.Scan(gt.SemverFrom(1, 2, 3)))
+	eq(gt.NullSemverFrom(1, 2, 3), val)
+
+	try(val.Scan((*gt.Semver)(nil)))
+	eq(gt.NullSemver{}, val)
+}
+
+func TestSemverConstraint(t *testing.T) {
+	t.Run(`Parse`, func(t *testing.T) {
+		test := func(exp, src string) {
+			t.Helper()
+			eq(exp, gt.ParseSemverConstraint(src).String())
+		}
+
+		test(``, ``)
+		test(``, `   `)
+		test(`>=1.2.0 <2.0.0`, `>=1.2.0 <2.0.0`)
+		test(`>=1.2.0 <2.0.0`, `>=1.2.0, <2.0.0`)
+		test(`>=1.2.0 <2.0.0`, `  >= 1.2.0   < 2.0.0 `)
+		test(`^1.4`, `^1.4`)
+		test(`~1.4.2`, `~1.4.2`)
+		test(`1.2 || 2`, `1.2.x||2.*`)
+		test(`*`, `x`)
+		test(`=1.0.0-rc.1+build`, `=1.0.0-rc.1+build`)
+		test(`<2.0.0-0`, `<2.0.0-0`)
+
+		var val gt.SemverConstraint
+		fail(val.Parse(`>=1.2`))
+		fail(val.Parse(`<1`))
+		fail(val.Parse(`>=`))
+		fail(val.Parse(`>=>1.2.3`))
+		fail(val.Parse(`!1.2.3`))
+		fail(val.Parse(`^*`))
+		fail(val.Parse(`1.2 ||`))
+		fail(val.Parse(`|| 1.2`))
+		fail(val.Parse(`1.2-rc.1`))
+		fail(val.Parse(`1.x.3`))
+		fail(val.Parse(`v1.2.3`))
+		fail(val.Parse(`>=1.2.3<2.0.0`))
+		eq(gt.SemverConstraint{}, val)
+	})
+
+	t.Run(`Match`, func(t *testing.T) {
+		test := func(src string, yes []string, no []string) {
+			t.Helper()
+			con := gt.ParseSemverConstraint(src)
+			for _, val := range yes {
+				if !con.Match(gt.ParseSemver(val)) {
+					t.Fatalf(`expected %q to match %q`, src, val)
+				}
+			}
+			for _, val := range no {
+				if con.Match(gt.ParseSemver(val)) {
+					t.Fatalf(`expected %q to not match %q`, src, val)
+				}
+			}
+		}
+
+		test(``,
+			[]string{`0.0.0`, `1.2.3`, `0.0.0-alpha`},
+			nil,
+		)
+		test(`*`,
+			[]string{`0.0.0`, `1.2.3`, `0.0.0-alpha`},
+			nil,
+		)
+		test(`1.2.3`,
+			[]string{`1.2.3`, `1.2.3+build`},
+			[]string{`1.2.4`, `1.2.3-rc.1`, `1.2.2`},
+		)
+		test(`>=1.2.0 <2.0.0`,
+			[]string{`1.2.0`, `1.9.9`, `2.0.0-alpha`},
+			[]string{`1.1.9`, `1.2.0-rc.1`, `2.0.0`},
+		)
+		test(`>=1.2.0 <2.0.0-0`,
+			[]string{`1.2.0`, `1.9.9`},
+			[]string{`2.0.0-alpha`, `2.0.0`},
+		)
+		test(`>1.2.3 <=1.3.0`,
+			[]string{`1.2.4`, `1.3.0`, `1.3.0-rc.1`},
+			[]string{`1.2.3`, `1.3.1`},
+		)
+		test(`^1.4`,
+			[]string{`1.4.0`, `1.9.0`, `1.4.1-rc.1`},
+			[]string{`1.3.9`, `1.4.0-rc.1`, `2.0.0-alpha`, `2.0.0`},
+		)
+		test(`^1.2.3`,
+			[]string{`1.2.3`, `1.99.0`},
+			[]string{`1.2.2`, `2.0.0`},
+		)
+		test(`^0.2.3`,
+			[]string{`0.2.3`, `0.2.9`},
+			[]string{`0.2.2`, `0.3.0`, `1.0.0`},
+		)
+		test(`^0.0.3`,
+			[]string{`0.0.3`},
+			[]string{`0.0.4`, `0.1.0`},
+		)
+		test(`^0`,
+			[]string{`0.0.0`, `0.99.0`},
+			[]string{`1.0.0`},
+		)
+		test(`~1.4.2`,
+			[]string{`1.4.2`, `1.4.9`},
+			[]string{`1.4.1`, `1.5.0`},
+		)
+		test(`~1.4`,
+			[]string{`1.4.0`, `1.4.9`},
+			[]string{`1.3.9`, `1.5.0`},
+		)
+		test(`~1`,
+			[]string{`1.0.0`, `1.9.0`},
+			[]string{`0.9.0`, `2.0.0`},
+		)
+		test(`1.2`,
+			[]string{`1.2.0`, `1.2.99`},
+			[]string{`1.1.0`, `1.3.0-alpha`, `1.3.0`},
+		)
+		test(`^1.0.0-rc.1`,
+			[]string{`1.0.0-rc.1`, `1.0.0-rc.2`, `1.0.0`, `1.5.0`},
+			[]string{`1.0.0-beta`, `2.0.0`},
+		)
+		test(`1.x || >=3.1.0 <3.2.0 || ~5.0`,
+			[]string{`1.5.0`, `3.1.5`, `5.0.3`},
+			[]string{`2.0.0`, `3.2.0`, `5.1.0`},
+		)
+		test(`^18446744073709551615.0.0`,
+			[]string{`18446744073709551615.0.0`, `18446744073709551615.1.0`},
+			[]string{`1.0.0`},
+		)
+	})
+
+	t.Run(`MatchNull`, func(t *testing.T) {
+		eq(true, gt.SemverConstraint{}.MatchNull(gt.NullSemver{}))
+		eq(false, gt.ParseSemverConstraint(`*`).MatchNull(gt.NullSemver{}))
+		eq(true, gt.ParseSemverConstraint(`^1`).MatchNull(gt.NullSemverFrom(1, 2, 3)))
+	})
+}
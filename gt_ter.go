@@ -227,8 +227,10 @@ Implement `sql.Scanner`, converting an arbitrary input to `gt.Ter` and
 modifying the receiver. Acceptable inputs:
 
   - `nil`         -> use `.Zero`
-  - `string`      -> use `.Parse`
-  - `[]byte`      -> use `.UnmarshalText`
+  - `string`      -> "t" or "f" as in Postgres text output, otherwise use `.Parse`
+  - `[]byte`      -> "t" or "f" as in Postgres text output, otherwise use `.UnmarshalText`
+  - `int`         -> 0 is false, 1 is true, other values are rejected
+  - `int64`       -> 0 is false, 1 is true, other values are rejected
   - `bool`        -> use `.SetBool`
   - `*bool`       -> use `.SetBoolPtr`
   - `Ter`         -> assign
@@ -241,11 +243,25 @@ func (self *Ter) Scan(src any) error {
 		return nil
 
 	case string:
+		if val, ok := pgBoolTer(src); ok {
+			*self = val
+			return nil
+		}
 		return self.Parse(src)
 
 	case []byte:
+		if val, ok := pgBoolTer(bytesString(src)); ok {
+			*self = val
+			return nil
+		}
 		return self.UnmarshalText(src)
 
+	case int:
+		return self.scanInt(int64(src))
+
+	case int64:
+		return self.scanInt(src)
+
 	case bool:
 		self.SetBool(src)
 		return nil
@@ -267,8 +283,32 @@ func (self *Ter) Scan(src any) error {
 	}
 }
 
+func (self *Ter) scanInt(src int64) error {
+	switch src {
+	case 0:
+		*self = TerFalse
+		return nil
+	case 1:
+		*self = TerTrue
+		return nil
+	default:
+		return fmt.Errorf(`[gt] unable to convert %v to %T: expected 0 or 1`, src, *self)
+	}
+}
+
+func pgBoolTer(src string) (Ter, bool) {
+	switch src {
+	case `t`:
+		return TerTrue, true
+	case `f`:
+		return TerFalse, true
+	default:
+		return TerNull, false
+	}
+}
+
 func (self Ter) invalid() error {
-	return fmt.Errorf(`[gt] unrecognized value of %[1]T: %[1]v`, self)
+	return fmt.Errorf(`[gt] unrecognized value of %T: %v`, self, byte(self))
 }
 
 // Sets the receiver to the result of `gt.BoolTer`.
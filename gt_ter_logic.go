@@ -0,0 +1,164 @@
+package gt
+
+/*
+Logical conjunction in three-valued (Kleene) logic, equivalent to SQL `and`:
+
+  - If either operand is false, the result is false.
+  - Otherwise, if either operand is null, the result is null.
+  - Otherwise the result is true.
+*/
+func (self Ter) And(val Ter) Ter {
+	self.valid()
+	val.valid()
+
+	if self == TerFalse || val == TerFalse {
+		return TerFalse
+	}
+	if self == TerNull || val == TerNull {
+		return TerNull
+	}
+	return TerTrue
+}
+
+/*
+Logical disjunction in three-valued (Kleene) logic, equivalent to SQL `or`:
+
+  - If either operand is true, the result is true.
+  - Otherwise, if either operand is null, the result is null.
+  - Otherwise the result is false.
+*/
+func (self Ter) Or(val Ter) Ter {
+	self.valid()
+	val.valid()
+
+	if self == TerTrue || val == TerTrue {
+		return TerTrue
+	}
+	if self == TerNull || val == TerNull {
+		return TerNull
+	}
+	return TerFalse
+}
+
+/*
+Logical negation in three-valued (Kleene) logic, equivalent to SQL `not`.
+Swaps true and false; null remains null.
+*/
+func (self Ter) Not() Ter {
+	switch self {
+	case TerNull:
+		return TerNull
+	case TerFalse:
+		return TerTrue
+	case TerTrue:
+		return TerFalse
+	default:
+		panic(self.invalid())
+	}
+}
+
+/*
+Exclusive disjunction in three-valued (Kleene) logic. If either operand is null,
+the result is null. Otherwise the result is true if the operands differ.
+Equivalent to SQL `<>` on booleans.
+*/
+func (self Ter) Xor(val Ter) Ter {
+	self.valid()
+	val.valid()
+
+	if self == TerNull || val == TerNull {
+		return TerNull
+	}
+	return BoolTer(self != val)
+}
+
+/*
+Material implication in three-valued (Kleene) logic, equivalent to
+`self.Not().Or(val)` and to SQL `not self or val`. False only when the receiver
+is true and the operand is false; null when the result depends on a null.
+*/
+func (self Ter) Implies(val Ter) Ter { return self.Not().Or(val) }
+
+/*
+Variadic version of `gt.Ter.And`, equivalent to the Postgres aggregate
+`bool_and` except for the empty case. Returns true if there are no inputs,
+following the convention that an empty conjunction is true. Stops early on
+false.
+*/
+func TerAll(src ...Ter) Ter {
+	out := TerTrue
+	for _, val := range src {
+		out = out.And(val)
+		if out == TerFalse {
+			break
+		}
+	}
+	return out
+}
+
+/*
+Variadic version of `gt.Ter.Or`, equivalent to the Postgres aggregate `bool_or`
+except for the empty case. Returns false if there are no inputs, following the
+convention that an empty disjunction is false. Stops early on true.
+*/
+func TerAny(src ...Ter) Ter {
+	out := TerFalse
+	for _, val := range src {
+		out = out.Or(val)
+		if out == TerTrue {
+			break
+		}
+	}
+	return out
+}
+
+/*
+Converts `gt.NullInt` to ternary, similar to the SQL cast `int::boolean`, where
+non-zero is true. Unlike SQL, where zero is false, zero maps to `gt.TerNull`,
+following the convention of this package that zero is null. As a result, this
+never returns `gt.TerFalse`. When zero must mean false, store the value as
+`gt.Ter` or as a non-nullable integer instead.
+*/
+func TerFromNullInt(val NullInt) Ter {
+	if val.IsNull() {
+		return TerNull
+	}
+	return TerTrue
+}
+
+/*
+Same as `gt.TerFromNullInt` but for `gt.NullUint`: zero maps to `gt.TerNull`,
+and non-zero to `gt.TerTrue`.
+*/
+func TerFromNullUint(val NullUint) Ter {
+	if val.IsNull() {
+		return TerNull
+	}
+	return TerTrue
+}
+
+/*
+Same as `gt.TerFromNullInt` but for `gt.NullFloat`: zero maps to `gt.TerNull`,
+and any other value, including NaN, to `gt.TerTrue`.
+*/
+func TerFromNullFloat(val NullFloat) Ter {
+	if val.IsNull() {
+		return TerNull
+	}
+	return TerTrue
+}
+
+/*
+Converts `gt.NullString` to ternary using `gt.Ter.Parse`. The empty string is
+`gt.TerNull`.
+*/
+func TerFromNullString(val NullString) (out Ter, err error) {
+	err = out.Parse(string(val))
+	return
+}
+
+func (self Ter) valid() {
+	if self > TerTrue {
+		panic(self.invalid())
+	}
+}
@@ -2,6 +2,7 @@ package gt_test
 
 import (
 	"fmt"
+	"math"
 	"testing"
 
 	"github.com/mitranim/gt"
@@ -17,3 +18,119 @@ func TestTer(t *testing.T) {
 		eq(`gt.Ter(255)`, fmt.Sprintf(`%#v`, gt.Ter(255)))
 	})
 }
+
+func TestTer_logic(t *testing.T) {
+	const (
+		N = gt.TerNull
+		F = gt.TerFalse
+		T = gt.TerTrue
+	)
+
+	// Operands in order: null, false, true. Rows are receivers, columns are
+	// arguments. Matches the truth tables of SQL `and`, `or`, `<>`.
+	test := func(fun func(gt.Ter, gt.Ter) gt.Ter, exp [3][3]gt.Ter) {
+		t.Helper()
+		for ind, one := range []gt.Ter{N, F, T} {
+			for col, two := range []gt.Ter{N, F, T} {
+				eq(exp[ind][col], fun(one, two))
+			}
+		}
+	}
+
+	test(gt.Ter.And, [3][3]gt.Ter{
+		{N, F, N},
+		{F, F, F},
+		{N, F, T},
+	})
+
+	test(gt.Ter.Or, [3][3]gt.Ter{
+		{N, N, T},
+		{N, F, T},
+		{T, T, T},
+	})
+
+	test(gt.Ter.Xor, [3][3]gt.Ter{
+		{N, N, N},
+		{N, F, T},
+		{N, T, F},
+	})
+
+	test(gt.Ter.Implies, [3][3]gt.Ter{
+		{N, N, T},
+		{T, T, T},
+		{N, F, T},
+	})
+
+	eq(N, N.Not())
+	eq(T, F.Not())
+	eq(F, T.Not())
+
+	panics(t, `unrecognized value`, func() { gt.Ter(3).Not() })
+	panics(t, `unrecognized value`, func() { gt.Ter(3).And(F) })
+}
+
+func TestTerAll(t *testing.T) {
+	eq(gt.TerTrue, gt.TerAll())
+	eq(gt.TerTrue, gt.TerAll(gt.TerTrue, gt.TerTrue))
+	eq(gt.TerNull, gt.TerAll(gt.TerTrue, gt.TerNull))
+	eq(gt.TerFalse, gt.TerAll(gt.TerNull, gt.TerFalse, gt.TerTrue))
+}
+
+func TestTerAny(t *testing.T) {
+	eq(gt.TerFalse, gt.TerAny())
+	eq(gt.TerFalse, gt.TerAny(gt.TerFalse, gt.TerFalse))
+	eq(gt.TerNull, gt.TerAny(gt.TerFalse, gt.TerNull))
+	eq(gt.TerTrue, gt.TerAny(gt.TerNull, gt.TerTrue, gt.TerFalse))
+}
+
+func TestTerFrom(t *testing.T) {
+	eq(gt.TerNull, gt.TerFromNullInt(0))
+	eq(gt.TerTrue, gt.TerFromNullInt(-1))
+	eq(gt.TerNull, gt.TerFromNullUint(0))
+	eq(gt.TerTrue, gt.TerFromNullUint(1))
+	eq(gt.TerNull, gt.TerFromNullFloat(0))
+	eq(gt.TerTrue, gt.TerFromNullFloat(0.5))
+	eq(gt.TerTrue, gt.TerFromNullFloat(gt.NullFloat(math.NaN())))
+
+	eq(gt.TerNull, tryTer(gt.TerFromNullString(``)))
+	eq(gt.TerFalse, tryTer(gt.TerFromNullString(`false`)))
+	eq(gt.TerTrue, tryTer(gt.TerFromNullString(`true`)))
+
+	_, err := gt.TerFromNullString(`maybe`)
+	fail(err)
+}
+
+func tryTer(val gt.Ter, err error) gt.Ter {
+	try(err)
+	return val
+}
+
+func TestTer_Scan(t *testing.T) {
+	test := func(exp gt.Ter, src any) {
+		t.Helper()
+		var val gt.Ter
+		try(val.Scan(src))
+		eq(exp, val)
+	}
+
+	test(gt.TerNull, nil)
+	test(gt.TerNull, ``)
+	test(gt.TerFalse, `false`)
+	test(gt.TerTrue, []byte(`true`))
+	test(gt.TerFalse, `f`)
+	test(gt.TerTrue, `t`)
+	test(gt.TerFalse, []byte(`f`))
+	test(gt.TerTrue, []byte(`t`))
+	test(gt.TerFalse, 0)
+	test(gt.TerTrue, 1)
+	test(gt.TerFalse, int64(0))
+	test(gt.TerTrue, int64(1))
+	test(gt.TerTrue, true)
+
+	var val gt.Ter
+	fail(val.Scan(`on`))
+	fail(val.Scan([]byte(`T`)))
+	fail(val.Scan(2))
+	fail(val.Scan(int64(-1)))
+	eq(gt.TerNull, val)
+}
@@ -0,0 +1,109 @@
+package gt
+
+import "time"
+
+/*
+Output settings for `gt.NullTime`, for APIs where timestamps must be compared as
+strings, or must match the precision of the database. The zero value is
+equivalent to the default `gt.NullTime` encoding: `time.RFC3339Nano` in the
+timestamp's own location. Used by `gt.NullTimeUtcSec`, `gt.NullTimeUtcMilli`
+and `gt.NullTimeUtcMicro`, and can be used directly:
+
+	enc := gt.TimeEncoder{UTC: true, Precision: time.Millisecond}
+	enc.String(gt.NullTimeNow()) // 2024-05-01T10:20:30.123Z
+*/
+type TimeEncoder struct {
+	// Converts timestamps to UTC before encoding.
+	UTC bool
+
+	/**
+	Truncates timestamps to the given precision, and encodes exactly as many
+	fractional digits as needed to represent it: 0 for `time.Second`, 3 for
+	`time.Millisecond`, 6 for `time.Microsecond` (matching Postgres), 9 for
+	`time.Nanosecond`. Zero means no truncation and variable fraction length,
+	like in `time.RFC3339Nano`.
+	*/
+	Precision time.Duration
+
+	// Encoding of infinity in `.Json`. Zero means "infinity" and "-infinity".
+	Infinity InfinityJson
+}
+
+/*
+Converts the timestamp according to the settings. Zero and infinity remain
+unchanged.
+*/
+func (self TimeEncoder) Time(val NullTime) NullTime {
+	if val.IsZero() || val.IsInfinite() {
+		return val
+	}
+
+	out := val.Time()
+	if self.UTC {
+		out = out.UTC()
+	}
+	if self.Precision > 0 {
+		out = out.Truncate(self.Precision)
+	}
+	return NullTime(out)
+}
+
+/*
+Same as `gt.NullTime.AppendTo`, but using the settings. Zero is appended as
+nothing.
+*/
+func (self TimeEncoder) AppendTo(buf []byte, val NullTime) []byte {
+	if val.IsZero() || val.IsInfinite() {
+		return val.AppendTo(buf)
+	}
+	return appendTimeDigits(buf, self.Time(val).Time(), self.digits())
+}
+
+// Same as `gt.NullTime.String`, but using the settings.
+func (self TimeEncoder) String(val NullTime) string {
+	if val.IsZero() {
+		return ``
+	}
+	return bytesString(self.AppendTo(make([]byte, 0, len(timeFormat)), val))
+}
+
+// Same as `gt.NullTime.MarshalText`, but using the settings.
+func (self TimeEncoder) Text(val NullTime) []byte {
+	if val.IsZero() {
+		return nil
+	}
+	return self.AppendTo(nil, val)
+}
+
+// Same as `gt.NullTime.MarshalJSON`, but using the settings.
+func (self TimeEncoder) Json(val NullTime) []byte {
+	if val.IsZero() || val.IsInfinite() {
+		return self.Infinity.NullTime(val)
+	}
+
+	buf := make([]byte, 0, len(timeFormat)+2)
+	buf = append(buf, '"')
+	buf = self.AppendTo(buf, val)
+	buf = append(buf, '"')
+	return buf
+}
+
+// Used by `gt.NullTimeUtcSec`, `gt.NullTimeUtcMilli`, `gt.NullTimeUtcMicro`.
+var (
+	timeEncoderUtcSec   = TimeEncoder{UTC: true, Precision: time.Second}
+	timeEncoderUtcMilli = TimeEncoder{UTC: true, Precision: time.Millisecond}
+	timeEncoderUtcMicro = TimeEncoder{UTC: true, Precision: time.Microsecond}
+)
+
+// Number of fractional digits, or -1 for variable length.
+func (self TimeEncoder) digits() int {
+	if self.Precision <= 0 {
+		return -1
+	}
+
+	digits := 9
+	for prec := self.Precision; digits > 0 && prec%10 == 0; prec /= 10 {
+		digits--
+	}
+	return digits
+}
@@ -0,0 +1,61 @@
+package gt_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/mitranim/gt"
+)
+
+func TestNullTimeUtcMilli_common(t *testing.T) {
+	var (
+		primZero    = time.Time{}
+		primNonZero = time.Date(1234, 5, 6, 7, 8, 9, 120000000, time.UTC)
+		textZero    = ``
+		textNonZero = `1234-05-06T07:08:09.120Z`
+		jsonZero    = bytesNull
+		jsonNonZero = jsonBytes(textNonZero)
+		zero        = gt.NullTimeUtcMilli(primZero)
+		nonZero     = gt.NullTimeUtcMilli(primNonZero)
+		dec         = new(gt.NullTimeUtcMilli)
+	)
+
+	eq(true, zero.IsNull())
+	eq(false, nonZero.IsNull())
+
+	testAny(t, primZero, primNonZero, textZero, textNonZero, jsonZero, jsonNonZero, zero, nonZero, dec)
+}
+
+func TestTimeEncoder(t *testing.T) {
+	loc, err := time.LoadLocation(`Europe/Berlin`)
+	try(err)
+
+	src := gt.NullTimeIn(2024, 5, 1, 12, 20, 30, 123456789, loc)
+
+	test := func(exp string, enc gt.TimeEncoder, val gt.NullTime) {
+		t.Helper()
+		eq(exp, enc.String(val))
+		eq(exp, string(enc.AppendTo(nil, val)))
+		eq(jsonBytes(exp), enc.Json(val))
+	}
+
+	eq(``, gt.TimeEncoder{UTC: true}.String(gt.NullTime{}))
+	eq(bytesNull, gt.TimeEncoder{UTC: true}.Json(gt.NullTime{}))
+	test(src.String(), gt.TimeEncoder{}, src)
+	test(`2024-05-01T10:20:30.123456789Z`, gt.TimeEncoder{UTC: true}, src)
+	test(`2024-05-01T12:20:30+02:00`, gt.TimeEncoder{Precision: time.Second}, src)
+	test(`2024-05-01T10:20:30Z`, gt.TimeEncoder{UTC: true, Precision: time.Second}, src)
+	test(`2024-05-01T10:20:30.123Z`, gt.TimeEncoder{UTC: true, Precision: time.Millisecond}, src)
+	test(`2024-05-01T10:20:30.123456Z`, gt.TimeEncoder{UTC: true, Precision: time.Microsecond}, src)
+	test(`2024-05-01T10:20:30.123456789Z`, gt.TimeEncoder{UTC: true, Precision: time.Nanosecond}, src)
+	test(`2024-05-01T10:20:30.100Z`, gt.TimeEncoder{UTC: true, Precision: time.Millisecond}, gt.NullTimeUTC(2024, 5, 1, 10, 20, 30, 100000000))
+	test(`2024-05-01T10:20:30.000Z`, gt.TimeEncoder{UTC: true, Precision: time.Millisecond}, gt.NullTimeUTC(2024, 5, 1, 10, 20, 30, 0))
+	test(`2024-05-01T10:20:30.0Z`, gt.TimeEncoder{UTC: true, Precision: 100 * time.Millisecond}, gt.NullTimeUTC(2024, 5, 1, 10, 20, 30, 0))
+	test(`2024-05-01T10:20:00Z`, gt.TimeEncoder{UTC: true, Precision: time.Minute}, src)
+	test(`10000-01-01T00:00:00.000Z`, gt.TimeEncoder{UTC: true, Precision: time.Millisecond}, gt.NullTimeUTC(10000, 1, 1, 0, 0, 0, 0))
+
+	eq(`2024-05-01T10:20:30Z`, gt.NullTimeUtcSec(src).String())
+	eq(`2024-05-01T10:20:30.123Z`, gt.NullTimeUtcMilli(src).String())
+	eq(`2024-05-01T10:20:30.123456Z`, gt.NullTimeUtcMicro(src).String())
+	eq(time.Date(2024, 5, 1, 10, 20, 30, 123456000, time.UTC), gt.NullTimeUtcMicro(src).Get())
+}
@@ -0,0 +1,306 @@
+package gt
+
+import "time"
+
+/*
+Moved to a separate file due to length, to keep the main files browsable.
+
+Allocation-free parsing and formatting of RFC 3339 timestamps and ISO 8601 dates,
+used by `gt.NullTime` and `gt.NullDate`. Parsing handles the common subset of
+the format: 4-digit year, "T" separator, optional fraction of up to 9 digits,
+"Z" or a numeric offset. Anything else falls back to `time.Parse`, which
+guarantees identical results and error messages for all inputs.
+
+Benchmarks at the time of writing (Go 1.27), see `t_bench_test.go`:
+
+	before:
+
+		ParseNullTimeNormal      75.49 ns/op  0 B/op  0 allocs/op
+		NullTime_UnmarshalJSON  498.5  ns/op  0 B/op  0 allocs/op
+		NullTime_Scan_bytes     156.4  ns/op 24 B/op  1 allocs/op
+		ParseNullDate           156.6  ns/op  0 B/op  0 allocs/op
+		NullDate_AppendTo       154.0  ns/op  0 B/op  0 allocs/op
+
+	after:
+
+		ParseNullTimeNormal      39.46 ns/op  0 B/op  0 allocs/op
+		NullTime_UnmarshalJSON   51.90 ns/op  0 B/op  0 allocs/op
+		NullTime_Scan_bytes     118.8  ns/op 24 B/op  1 allocs/op
+		ParseNullDate            35.29 ns/op  0 B/op  0 allocs/op
+		NullDate_AppendTo        20.49 ns/op  0 B/op  0 allocs/op
+
+(The scan benchmark includes boxing `[]byte` into `any`, which allocates in
+the caller.)
+*/
+func parseTimeFast(src string) (time.Time, bool) {
+	if len(src) < len(`2006-01-02T15:04:05Z`) || src[10] != 'T' {
+		return time.Time{}, false
+	}
+
+	year, month, day, ok := parseDateFast(src[:10])
+	if !ok {
+		return time.Time{}, false
+	}
+
+	hour, ok0 := parseDigits2(src, 11)
+	min, ok1 := parseDigits2(src, 14)
+	sec, ok2 := parseDigits2(src, 17)
+	if !(ok0 && ok1 && ok2) ||
+		src[13] != ':' || src[16] != ':' ||
+		hour > 23 || min > 59 || sec > 59 {
+		return time.Time{}, false
+	}
+
+	pos := 19
+	var nsec int
+
+	if src[pos] == '.' {
+		pos++
+		start := pos
+		for pos < len(src) && charsetDigitDec.has(src[pos]) {
+			nsec = inc(nsec, src[pos])
+			pos++
+		}
+
+		digits := pos - start
+		if digits < 1 || digits > 9 {
+			return time.Time{}, false
+		}
+		for ; digits < 9; digits++ {
+			nsec *= 10
+		}
+	}
+
+	if pos >= len(src) {
+		return time.Time{}, false
+	}
+
+	if src[pos] == 'Z' {
+		if pos+1 != len(src) {
+			return time.Time{}, false
+		}
+		return time.Date(year, month, day, hour, min, sec, nsec, time.UTC), true
+	}
+
+	if len(src)-pos != len(`+07:00`) || src[pos+3] != ':' {
+		return time.Time{}, false
+	}
+
+	offHour, ok0 := parseDigits2(src, pos+1)
+	offMin, ok1 := parseDigits2(src, pos+4)
+	if !(ok0 && ok1) || offHour > 23 || offMin > 59 {
+		return time.Time{}, false
+	}
+
+	off := offHour*3600 + offMin*60
+	switch src[pos] {
+	case '+':
+	case '-':
+		off = -off
+	default:
+		return time.Time{}, false
+	}
+
+	val := time.Date(year, month, day, hour, min, sec, nsec, time.UTC).Add(-time.Duration(off) * time.Second)
+	return timeWithOffset(val, off), true
+}
+
+/*
+Mirrors the behavior of `time.Parse`: if the offset matches the local zone at
+that instant, uses `time.Local`, otherwise a fixed zone. `time.FixedZone`
+doesn't allocate for whole-hour offsets, which are cached by the standard
+library.
+*/
+func timeWithOffset(val time.Time, off int) time.Time {
+	local := val.In(time.Local)
+	_, localOff := local.Zone()
+	if localOff == off {
+		return local
+	}
+	return val.In(time.FixedZone(``, off))
+}
+
+// Parses "YYYY-MM-DD", validating the day of month.
+func parseDateFast(src string) (int, time.Month, int, bool) {
+	if len(src) != len(dateFormat) || src[4] != '-' || src[7] != '-' {
+		return 0, 0, 0, false
+	}
+
+	year0, ok0 := parseDigits2(src, 0)
+	year1, ok1 := parseDigits2(src, 2)
+	month, ok2 := parseDigits2(src, 5)
+	day, ok3 := parseDigits2(src, 8)
+	if !(ok0 && ok1 && ok2 && ok3) {
+		return 0, 0, 0, false
+	}
+
+	year := year0*100 + year1
+	if month < 1 || month > 12 || day < 1 || day > daysInMonthFast(year, time.Month(month)) {
+		return 0, 0, 0, false
+	}
+	return year, time.Month(month), day, true
+}
+
+func parseDigits2(src string, pos int) (int, bool) {
+	if pos+1 >= len(src) || !charsetDigitDec.has(src[pos]) || !charsetDigitDec.has(src[pos+1]) {
+		return 0, false
+	}
+	return undigit(src[pos])*10 + undigit(src[pos+1]), true
+}
+
+func daysInMonthFast(year int, month time.Month) int {
+	switch month {
+	case time.February:
+		if year%4 == 0 && (year%100 != 0 || year%400 == 0) {
+			return 29
+		}
+		return 28
+	case time.April, time.June, time.September, time.November:
+		return 30
+	default:
+		return 31
+	}
+}
+
+/*
+Appends the timestamp in the `time.RFC3339Nano` format. Falls back on
+`time.Time.AppendFormat` for years outside of 0..9999, which can't be
+represented in RFC 3339.
+*/
+func appendTimeFast(buf []byte, val time.Time) []byte {
+	return appendTimeDigits(buf, val, -1)
+}
+
+/*
+Same as `appendTimeFast`, but with exactly the given number of fractional
+digits, between 0 and 9, truncating the rest. Negative means variable length,
+like in `time.RFC3339Nano`.
+*/
+func appendTimeDigits(buf []byte, val time.Time, digits int) []byte {
+	_, off := val.Zone()
+
+	// Civil seconds since the Unix epoch.
+	secs := val.Unix() + int64(off)
+	days := secs / secsInDay
+	rem := int(secs % secsInDay)
+	if rem < 0 {
+		days--
+		rem += secsInDay
+	}
+
+	year, month, day := civilFromDays(days)
+	if year < 0 || year > 9999 {
+		return val.AppendFormat(buf, timeFormatDigits(digits))
+	}
+
+	hour, min, sec := rem/3600, (rem%3600)/60, rem%60
+
+	buf = appendDateFast(buf, year, month, day)
+	buf = append(buf, 'T')
+	buf = appendDigits2(buf, hour)
+	buf = append(buf, ':')
+	buf = appendDigits2(buf, min)
+	buf = append(buf, ':')
+	buf = appendDigits2(buf, sec)
+
+	nsec := val.Nanosecond()
+	if digits > 0 || (digits < 0 && nsec != 0) {
+		var arr [10]byte
+		arr[0] = '.'
+		for ind := 9; ind > 0; ind-- {
+			arr[ind] = byte('0' + nsec%10)
+			nsec /= 10
+		}
+
+		end := 1 + digits
+		if digits < 0 {
+			end = len(arr)
+			for arr[end-1] == '0' {
+				end--
+			}
+		}
+		buf = append(buf, arr[:end]...)
+	}
+
+	if off == 0 {
+		return append(buf, 'Z')
+	}
+
+	if off < 0 {
+		buf = append(buf, '-')
+		off = -off
+	} else {
+		buf = append(buf, '+')
+	}
+
+	buf = appendDigits2(buf, off/3600)
+	buf = append(buf, ':')
+	return appendDigits2(buf, (off%3600)/60)
+}
+
+const secsInDay = 24 * 60 * 60
+
+// Layout for `time.Time.AppendFormat` equivalent to `appendTimeDigits`.
+func timeFormatDigits(digits int) string {
+	if digits < 0 || digits > 9 {
+		return timeFormat
+	}
+	return `2006-01-02T15:04:05` + `.000000000`[:digitsLen(digits)] + `Z07:00`
+}
+
+func digitsLen(digits int) int {
+	if digits <= 0 {
+		return 0
+	}
+	return digits + 1
+}
+
+/*
+Converts days since 1970-01-01 to a proleptic Gregorian date. Algorithm by
+Howard Hinnant: https://howardhinnant.github.io/date_algorithms.html.
+Much cheaper than `time.Time.Date` followed by `time.Time.Clock`, which
+repeat the same computation.
+*/
+func civilFromDays(days int64) (int, time.Month, int) {
+	days += 719468
+
+	era := days
+	if era < 0 {
+		era -= 146096
+	}
+	era /= 146097
+
+	doe := days - era*146097
+	yoe := (doe - doe/1460 + doe/36524 - doe/146096) / 365
+	doy := doe - (365*yoe + yoe/4 - yoe/100)
+	mp := (5*doy + 2) / 153
+	day := doy - (153*mp+2)/5 + 1
+
+	month := mp + 3
+	if mp >= 10 {
+		month = mp - 9
+	}
+
+	year := yoe + era*400
+	if month <= 2 {
+		year++
+	}
+	return int(year), time.Month(month), int(day)
+}
+
+/*
+Appends the date in the "YYYY-MM-DD" format. The caller is responsible for
+ensuring that the year is within 0..9999.
+*/
+func appendDateFast(buf []byte, year int, month time.Month, day int) []byte {
+	buf = appendDigits2(buf, year/100)
+	buf = appendDigits2(buf, year%100)
+	buf = append(buf, '-')
+	buf = appendDigits2(buf, int(month))
+	buf = append(buf, '-')
+	return appendDigits2(buf, day)
+}
+
+func appendDigits2(buf []byte, val int) []byte {
+	return append(buf, byte('0'+val/10), byte('0'+val%10))
+}
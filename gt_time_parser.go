@@ -0,0 +1,186 @@
+package gt
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+)
+
+/*
+Returns a copy of the layouts used by `gt.LaxTimeParser`, in the order of
+attempts. Includes RFC 3339, the default output formats of Postgres
+`timestamptz` and `timestamp`, RFC 1123, RFC 850, ANSI C, and ISO 8601 dates in
+the extended and basic formats, such as "2024-05-01" and "20240501".
+*/
+func LaxTimeLayouts() []string { return append([]string(nil), laxTimeLayouts...) }
+
+/*
+Returns a copy of the parser used by `gt.LaxNullTime` and `gt.LaxNullDate`,
+which can be modified without affecting them. Useful as a starting point for a
+custom parser:
+
+	parser := gt.LaxTimeParser()
+	parser.Location = loc
+	val, err := parser.ParseNullTime(src)
+*/
+func LaxTimeParser() TimeParser {
+	out := laxTimeParser
+	out.Layouts = LaxTimeLayouts()
+	return out
+}
+
+// Should not be modified. See `gt.LaxTimeLayouts`.
+var laxTimeLayouts = []string{
+	time.RFC3339Nano,
+	`2006-01-02T15:04:05`,
+	`2006-01-02 15:04:05Z07:00`,
+	`2006-01-02 15:04:05Z07`,
+	`2006-01-02 15:04:05`,
+	time.RFC1123Z,
+	time.RFC1123,
+	time.RFC850,
+	time.ANSIC,
+	dateFormat,
+	dateBasicFormat,
+}
+
+// ISO 8601 basic date format.
+const dateBasicFormat = `20060102`
+
+// Should not be modified. See `gt.LaxTimeParser`.
+var laxTimeParser = TimeParser{Layouts: laxTimeLayouts}
+
+/*
+Configurable parser for `gt.NullTime` and `gt.NullDate`, for inputs that don't
+conform to the strict formats accepted by `gt.NullTime.Parse` and
+`gt.NullDate.Parse`, such as imports from CSV files or third party APIs. Can be
+used per call via `.ParseNullTime`, `.ParseNullDate`, `gt.NullTime.ParseWith`,
+`gt.NullDate.ParseWith`, or implicitly via the wrapper types `gt.LaxNullTime`
+and `gt.LaxNullDate`, which use `gt.LaxTimeParser`.
+
+The zero value of `gt.TimeParser` accepts only numeric Unix timestamps.
+*/
+type TimeParser struct {
+	// Layouts for `time.ParseInLocation`, tried in order.
+	Layouts []string
+
+	/**
+	Location for inputs without zone offsets, such as Postgres `timestamp`.
+	Nil means UTC. Doesn't affect inputs with zone offsets.
+	*/
+	Location *time.Location
+
+	/**
+	Unit of numeric inputs: one of `time.Second`, `time.Millisecond`,
+	`time.Microsecond`, `time.Nanosecond`. Zero means detection by magnitude:
+	below 1e11 is seconds, below 1e14 is milliseconds, below 1e17 is
+	microseconds, anything larger is nanoseconds. Detection is ambiguous for
+	millisecond timestamps before March 1973.
+	*/
+	UnixUnit time.Duration
+
+	// Disables numeric inputs.
+	NoUnix bool
+}
+
+/*
+Parses the input into `gt.NullTime`. If the input is empty, returns zero.
+Otherwise tries "infinity" and "-infinity", then each layout in order, then
+numeric Unix timestamps (unless disabled). Since layouts are tried first,
+digit-only inputs matching a layout, such as "20240501" for the ISO 8601 basic
+date format, are not treated as Unix timestamps.
+*/
+func (self TimeParser) ParseNullTime(src string) (_ NullTime, err error) {
+	if len(src) <= 0 {
+		return NullTime{}, nil
+	}
+
+	defer errParse(&err, src, `time`)
+
+	switch infinitySign(src) {
+	case 1:
+		return NullTimeInfinity, nil
+	case -1:
+		return NullTimeNegInfinity, nil
+	}
+
+	loc := self.loc()
+	for _, layout := range self.Layouts {
+		val, err := time.ParseInLocation(layout, src, loc)
+		if err == nil {
+			return NullTime(val), nil
+		}
+	}
+
+	if !self.NoUnix && isIntString(src) {
+		return self.parseUnix(src)
+	}
+	return NullTime{}, errFormatMismatch
+}
+
+/*
+Parses the input into `gt.NullDate`. If the input is empty, returns zero.
+Otherwise uses the same algorithm as `.ParseNullTime`, and takes the civil date
+in the timestamp's own location: the date of "2024-05-01T23:00:00-05:00" is
+2024-05-01. Numeric timestamps use `.Location`.
+*/
+func (self TimeParser) ParseNullDate(src string) (NullDate, error) {
+	val, err := self.ParseNullTime(src)
+	if err != nil || val.IsZero() {
+		return NullDate{}, err
+	}
+	return val.NullDate(), nil
+}
+
+func (self TimeParser) parseUnix(src string) (NullTime, error) {
+	num, err := strconv.ParseInt(src, 10, 64)
+	if err != nil {
+		return NullTime{}, err
+	}
+	return self.unix(num)
+}
+
+func (self TimeParser) unix(num int64) (NullTime, error) {
+	unit := self.UnixUnit
+	if unit == 0 {
+		unit = detectUnixUnit(num)
+	}
+
+	var val time.Time
+	switch unit {
+	case time.Second:
+		val = time.Unix(num, 0)
+	case time.Millisecond:
+		val = time.UnixMilli(num)
+	case time.Microsecond:
+		val = time.UnixMicro(num)
+	case time.Nanosecond:
+		val = time.Unix(0, num)
+	default:
+		return NullTime{}, fmt.Errorf(`unsupported Unix timestamp unit %v`, unit)
+	}
+	return NullTime(val.In(self.loc())), nil
+}
+
+func (self TimeParser) loc() *time.Location {
+	if self.Location != nil {
+		return self.Location
+	}
+	return time.UTC
+}
+
+func detectUnixUnit(val int64) time.Duration {
+	if val < 0 {
+		val = -val
+	}
+	switch {
+	case val < 1e11:
+		return time.Second
+	case val < 1e14:
+		return time.Millisecond
+	case val < 1e17:
+		return time.Microsecond
+	default:
+		return time.Nanosecond
+	}
+}
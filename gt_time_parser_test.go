@@ -0,0 +1,172 @@
+package gt_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/mitranim/gt"
+)
+
+func TestLaxNullTime_common(t *testing.T) {
+	var (
+		primZero    = time.Time{}
+		primNonZero = time.Date(1234, 5, 6, 0, 0, 0, 0, time.UTC)
+		textZero    = ``
+		textNonZero = `1234-05-06T00:00:00Z`
+		jsonZero    = bytesNull
+		jsonNonZero = jsonBytes(primNonZero)
+		zero        = gt.LaxNullTime(primZero)
+		nonZero     = gt.LaxNullTime(primNonZero)
+		dec         = new(gt.LaxNullTime)
+	)
+
+	eq(true, zero.IsNull())
+	eq(false, nonZero.IsNull())
+
+	testAny(t, primZero, primNonZero, textZero, textNonZero, jsonZero, jsonNonZero, zero, nonZero, dec)
+}
+
+func TestLaxNullDate_common(t *testing.T) {
+	var (
+		primZero    = time.Time{}
+		primNonZero = time.Date(1234, 5, 6, 0, 0, 0, 0, time.UTC)
+		textZero    = ``
+		textNonZero = `1234-05-06`
+		jsonZero    = bytesNull
+		jsonNonZero = jsonBytes(textNonZero)
+		zero        = gt.LaxNullDate{}
+		nonZero     = gt.LaxNullDate(gt.NullDateFrom(1234, 5, 6))
+		dec         = new(gt.LaxNullDate)
+	)
+
+	eq(true, zero.IsNull())
+	eq(false, nonZero.IsNull())
+
+	testAny(t, primZero, primNonZero, textZero, textNonZero, jsonZero, jsonNonZero, zero, nonZero, dec)
+}
+
+func TestTimeParser_ParseNullTime(t *testing.T) {
+	loc, err := time.LoadLocation(`Europe/Berlin`)
+	try(err)
+
+	parser := gt.LaxTimeParser()
+	parser.Location = loc
+
+	test := func(exp gt.NullTime, src string) {
+		t.Helper()
+		val, err := parser.ParseNullTime(src)
+		try(err)
+		eq(exp.Time().UnixNano(), val.Time().UnixNano())
+	}
+
+	test(gt.NullTime{}, ``)
+	test(gt.NullTimeUTC(2024, 5, 1, 10, 20, 30, 0), `2024-05-01T10:20:30Z`)
+	test(gt.NullTimeUTC(2024, 5, 1, 10, 20, 30, 123000000), `2024-05-01T12:20:30.123+02:00`)
+	test(gt.NullTimeUTC(2024, 5, 1, 8, 20, 30, 0), `2024-05-01T10:20:30`)
+	test(gt.NullTimeUTC(2024, 5, 1, 8, 20, 30, 0), `2024-05-01 10:20:30+02`)
+	test(gt.NullTimeUTC(2024, 5, 1, 4, 50, 30, 0), `2024-05-01 10:20:30+05:30`)
+	test(gt.NullTimeUTC(2024, 5, 1, 8, 20, 30, 456789000), `2024-05-01 10:20:30.456789`)
+	test(gt.NullTimeUTC(2024, 1, 1, 9, 20, 30, 0), `2024-01-01 10:20:30`)
+	test(gt.NullTimeUTC(1994, 11, 6, 8, 49, 37, 0), `Sun, 06 Nov 1994 08:49:37 GMT`)
+	test(gt.NullTimeUTC(1994, 11, 6, 7, 49, 37, 0), `Sun, 06 Nov 1994 08:49:37 +0100`)
+	test(gt.NullTimeUTC(1994, 11, 6, 8, 49, 37, 0), `Sunday, 06-Nov-94 08:49:37 GMT`)
+	test(gt.NullTimeUTC(1994, 11, 6, 7, 49, 37, 0), `Sun Nov  6 08:49:37 1994`)
+	test(gt.NullTimeUTC(2024, 4, 30, 22, 0, 0, 0), `2024-05-01`)
+	test(gt.NullTimeUTC(2023, 12, 31, 23, 0, 0, 0), `20240101`)
+
+	test(gt.NullTimeUTC(2009, 2, 13, 23, 31, 30, 0), `1234567890`)
+	test(gt.NullTimeUTC(2009, 2, 13, 23, 31, 30, 123000000), `1234567890123`)
+	test(gt.NullTimeUTC(2009, 2, 13, 23, 31, 30, 123456000), `1234567890123456`)
+	test(gt.NullTimeUTC(2009, 2, 13, 23, 31, 30, 123456789), `1234567890123456789`)
+	test(gt.NullTimeUTC(1930, 11, 18, 0, 28, 30, 0), `-1234567890`)
+
+	val, err := parser.ParseNullTime(`1234567890`)
+	try(err)
+	eq(loc, val.Location())
+
+	parser.UnixUnit = time.Millisecond
+	test(gt.NullTimeUTC(1970, 1, 15, 6, 56, 7, 890000000), `1234567890`)
+
+	t.Run(`invalid`, func(t *testing.T) {
+		var parser gt.TimeParser
+
+		_, err := parser.ParseNullTime(`2024-05-01T10:20:30Z`)
+		fail(err)
+
+		parser = gt.LaxTimeParser()
+		parser.NoUnix = true
+
+		_, err = parser.ParseNullTime(`1234567890`)
+		fail(err)
+
+		_, err = parser.ParseNullTime(`01/05/2024`)
+		fail(err)
+	})
+
+	t.Run(`strict default`, func(t *testing.T) {
+		var val gt.NullTime
+		fail(val.Parse(`2024-05-01 10:20:30`))
+		fail(val.Parse(`Sun, 06 Nov 1994 08:49:37 GMT`))
+		eq(gt.NullTime{}, val)
+	})
+}
+
+func TestTimeParser_ParseNullDate(t *testing.T) {
+	test := func(exp gt.NullDate, src string) {
+		t.Helper()
+		eq(exp, gt.ParseLaxNullDate(src).NullDate())
+	}
+
+	test(gt.NullDate{}, ``)
+	test(gt.NullDateFrom(2024, 5, 1), `2024-05-01`)
+	test(gt.NullDateFrom(2024, 5, 1), `2024-05-01T23:00:00-05:00`)
+	test(gt.NullDateFrom(2024, 5, 1), `2024-05-01 23:00:00`)
+	test(gt.NullDateFrom(1994, 11, 6), `Sun, 06 Nov 1994 08:49:37 GMT`)
+	test(gt.NullDateFrom(2009, 2, 13), `1234567890`)
+
+	var val gt.NullDate
+	try(val.ParseWith(`2024-05-01 10:20:30`, gt.LaxTimeParser()))
+	eq(gt.NullDateFrom(2024, 5, 1), val)
+
+	fail(val.ParseWith(`nope`, gt.LaxTimeParser()))
+	eq(gt.NullDateFrom(2024, 5, 1), val)
+
+	// Digit-only dates are not Unix timestamps.
+	test(gt.NullDateFrom(2024, 1, 1), `20240101`)
+	test(gt.NullDateFrom(1970, 4, 26), `10000000`)
+	test(gt.NullDateFrom(1970, 1, 12), `1000000`)
+	test(gt.NullDateFrom(1973, 3, 3), `100000000`)
+}
+
+func TestLaxTimeParser_copy(t *testing.T) {
+	parser := gt.LaxTimeParser()
+	parser.Layouts[0] = `nope`
+	parser.NoUnix = true
+
+	eq(time.RFC3339Nano, gt.LaxTimeLayouts()[0])
+	eq(time.RFC3339Nano, gt.LaxTimeParser().Layouts[0])
+	eq(false, gt.LaxTimeParser().NoUnix)
+	eq(gt.NullDateFrom(2009, 2, 13), gt.ParseLaxNullDate(`1234567890`).NullDate())
+
+	layouts := gt.LaxTimeLayouts()
+	layouts[0] = `nope`
+	eq(time.RFC3339Nano, gt.LaxTimeLayouts()[0])
+}
+
+func TestLaxNullTime_decode(t *testing.T) {
+	var val gt.LaxNullTime
+
+	try(val.UnmarshalJSON([]byte(`1234567890`)))
+	eq(gt.NullTimeUTC(2009, 2, 13, 23, 31, 30, 0), val.NullTime())
+
+	try(val.UnmarshalJSON([]byte(`"Sun, 06 Nov 1994 08:49:37 GMT"`)))
+	eq(gt.NullTimeUTC(1994, 11, 6, 8, 49, 37, 0).Time().Unix(), val.Time().Unix())
+
+	try(val.Scan(int64(1234567890123)))
+	eq(gt.NullTimeUTC(2009, 2, 13, 23, 31, 30, 123000000), val.NullTime())
+
+	try(val.Scan([]byte(`2024-05-01 10:20:30+02`)))
+	eq(`2024-05-01T10:20:30+02:00`, val.String())
+
+	fail(val.UnmarshalJSON([]byte(`true`)))
+}
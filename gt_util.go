@@ -3,6 +3,7 @@ package gt
 import (
 	"bytes"
 	"strconv"
+	"sync"
 	"time"
 	"unsafe"
 )
@@ -83,6 +84,8 @@ func hexDecode(one, two byte) (byte, bool) {
 	return ((hexDigits[one] << 4) | hexDigits[two]), (hexBools[one] && hexBools[two])
 }
 
+const hexLower = `0123456789abcdef`
+
 var hexDigits = [256]byte{
 	'0': 0,
 	'1': 1,
@@ -266,3 +269,25 @@ func noescape(src unsafe.Pointer) unsafe.Pointer {
 	//nolint:staticcheck
 	return unsafe.Pointer(out ^ 0)
 }
+
+var locationCache sync.Map
+
+/*
+Same as `time.LoadLocation`, but caches successfully loaded locations, because
+loading may involve reading and parsing files from disk. Safe for concurrent
+use.
+*/
+func loadLocation(name string) (*time.Location, error) {
+	val, ok := locationCache.Load(name)
+	if ok {
+		return val.(*time.Location), nil
+	}
+
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, err
+	}
+
+	val, _ = locationCache.LoadOrStore(name, loc)
+	return val.(*time.Location), nil
+}
@@ -3,6 +3,7 @@ package gt
 import (
 	"fmt"
 	"io"
+	"time"
 )
 
 var (
@@ -13,6 +14,26 @@ var (
 	errUnrecLength    = fmt.Errorf(`unrecognized length`)
 	errDigitEof       = fmt.Errorf(`expected digit, got %w`, io.EOF)
 	errEmptySegment   = fmt.Errorf(`[gt] unexpected empty URL segment`)
+
+	errPrefixZone          = fmt.Errorf(`IPv6 zones are not supported in prefixes`)
+	errRange               = fmt.Errorf(`out of range`)
+	errDurationYearsMonths = fmt.Errorf(`years and months can't be converted to duration`)
+	errEmailAt             = fmt.Errorf(`missing "@"`)
+	errEmailLocal          = fmt.Errorf(`invalid local part`)
+	errEmailDomain         = fmt.Errorf(`invalid domain`)
+	errEmailLength         = fmt.Errorf(`too long`)
+	errPhonePrefix         = fmt.Errorf(`expected international prefix "+" or "00"`)
+	errPhoneCode           = fmt.Errorf(`unknown country calling code`)
+	errSemverEmptyIdent    = fmt.Errorf(`empty identifier`)
+	errSemverLeadingZero   = fmt.Errorf(`leading zero in numeric identifier`)
+	errSemverOp            = fmt.Errorf(`unrecognized operator`)
+	errSemverPartial       = fmt.Errorf(`comparison operators require a full version`)
+	errSlugFormat          = fmt.Errorf(`expected lowercase letters and digits separated by single hyphens`)
+	errLocationLocal       = fmt.Errorf(`"Local" depends on the environment, use an IANA zone name`)
+	errJsonPointerSyntax   = fmt.Errorf(`pointer must be empty or start with "/", and "~" must be followed by "0" or "1"`)
+	errJsonPointerValue    = fmt.Errorf(`value must be valid JSON`)
+	errJsonPointerParent   = fmt.Errorf(`parent must be an existing object or array`)
+	errJsonPointerIndex    = fmt.Errorf(`array index out of range`)
 )
 
 func errParse(ptr *error, src string, typ string) {
@@ -39,3 +60,57 @@ func errScanType(tar, inp any) error {
 func errInvalidSegment(val string) error {
 	return fmt.Errorf(`[gt] unexpected invalid URL segment %q`, val)
 }
+
+func errJsonUnixTime(src []byte) error {
+	return fmt.Errorf(`[gt] unable to decode %q into Unix timestamp: expected number or string`, src)
+}
+
+func errJsonDuration(src []byte) error {
+	return fmt.Errorf(`[gt] unable to decode %q into duration: expected string or number`, src)
+}
+
+func errScanDuration(src any, err error) error {
+	return fmt.Errorf(`[gt] unable to convert %v to duration: %w`, src, err)
+}
+
+func errJsonNumber(src []byte, typ any) error {
+	return fmt.Errorf(`[gt] unable to decode %q into %T: expected number`, src, typ)
+}
+
+func errEnumValue(src string, typ any, allowed string) error {
+	return fmt.Errorf(`[gt] unrecognized value %q for %T, expected one of: %v`, src, typ, allowed)
+}
+
+func errFlagName(src string, typ any, allowed string) error {
+	return fmt.Errorf(`[gt] unrecognized flag %q for %T, expected one of: %v`, src, typ, allowed)
+}
+
+func errScanLocation(src *time.Location) error {
+	return fmt.Errorf(`[gt] unable to scan location %q: expected a location loadable by name via time.LoadLocation`, src)
+}
+
+func errFlagWidth(src string, typ any, ind int) error {
+	return fmt.Errorf(`[gt] flag %q for %T has index %v which exceeds the bit width of the type`, src, typ, ind)
+}
+
+func errFlagBits(src string, typ any) error {
+	return fmt.Errorf(`[gt] unrecognized bits %v for %T`, src, typ)
+}
+
+func errInvalidJson(src []byte) error {
+	return fmt.Errorf(`[gt] unable to decode %q into JSON: invalid syntax`, src)
+}
+
+func errJsonDuplicateKey(key string) error {
+	return fmt.Errorf(`[gt] unable to canonicalize JSON: duplicate key %q`, key)
+}
+
+func errJsonDecode(src []byte, typ any, err error) error {
+	return fmt.Errorf(`[gt] unable to decode %q into %T: %w`, src, typ, err)
+}
+
+func errJsonPointer(ptr *error, src string) {
+	if *ptr != nil {
+		*ptr = fmt.Errorf(`[gt] unable to set JSON pointer %q: %w`, src, *ptr)
+	}
+}
@@ -1,8 +1,10 @@
 package gt_test
 
 import (
+	"encoding/json"
 	"path"
 	"testing"
+	"time"
 
 	"github.com/mitranim/gt"
 )
@@ -174,3 +176,94 @@ func Benchmark_NullUrl_MarshalJSON(b *testing.B) {
 		_, _ = val.MarshalJSON()
 	}
 }
+
+func Benchmark_time_Parse(b *testing.B) {
+	for ind := 0; ind < b.N; ind++ {
+		_, _ = time.Parse(time.RFC3339Nano, `1234-05-06T07:08:09.123456789+02:00`)
+	}
+}
+
+func Benchmark_ParseNullTimeOffset(b *testing.B) {
+	for ind := 0; ind < b.N; ind++ {
+		gt.ParseNullTime(`1234-05-06T07:08:09.123456789+02:00`)
+	}
+}
+
+func Benchmark_NullTime_String(b *testing.B) {
+	val := gt.ParseNullTime(`1234-05-06T07:08:09.123456789+02:00`)
+	b.ResetTimer()
+
+	for ind := 0; ind < b.N; ind++ {
+		_ = val.String()
+	}
+}
+
+func Benchmark_NullTime_AppendTo(b *testing.B) {
+	val := gt.ParseNullTime(`1234-05-06T07:08:09.123456789+02:00`)
+	buf := make([]byte, 0, 64)
+	b.ResetTimer()
+
+	for ind := 0; ind < b.N; ind++ {
+		_ = val.AppendTo(buf)
+	}
+}
+
+func Benchmark_json_Unmarshal_time_Time(b *testing.B) {
+	src := []byte(`"1234-05-06T07:08:09.123456789Z"`)
+	var val time.Time
+	b.ResetTimer()
+
+	for ind := 0; ind < b.N; ind++ {
+		try(json.Unmarshal(src, &val))
+	}
+}
+
+func Benchmark_NullTime_UnmarshalJSON(b *testing.B) {
+	src := []byte(`"1234-05-06T07:08:09.123456789Z"`)
+	var val gt.NullTime
+	b.ResetTimer()
+
+	for ind := 0; ind < b.N; ind++ {
+		try(val.UnmarshalJSON(src))
+	}
+}
+
+func Benchmark_NullTime_Scan_bytes(b *testing.B) {
+	src := []byte(`1234-05-06T07:08:09.123456789Z`)
+	var val gt.NullTime
+	b.ResetTimer()
+
+	for ind := 0; ind < b.N; ind++ {
+		try(val.Scan(src))
+	}
+}
+
+func Benchmark_NullDate_UnmarshalJSON(b *testing.B) {
+	src := []byte(`"1234-05-06"`)
+	var val gt.NullDate
+	b.ResetTimer()
+
+	for ind := 0; ind < b.N; ind++ {
+		try(val.UnmarshalJSON(src))
+	}
+}
+
+func Benchmark_NullDate_Scan_bytes(b *testing.B) {
+	src := []byte(`1234-05-06`)
+	var val gt.NullDate
+	b.ResetTimer()
+
+	for ind := 0; ind < b.N; ind++ {
+		try(val.Scan(src))
+	}
+}
+
+func Benchmark_NullDate_AppendTo(b *testing.B) {
+	val := gt.ParseNullDate(`1234-05-06`)
+	buf := make([]byte, 0, 64)
+	b.ResetTimer()
+
+	for ind := 0; ind < b.N; ind++ {
+		_ = val.AppendTo(buf)
+	}
+}